@@ -33,7 +33,7 @@ func CertsMain() {
 	}
 	fmt.Printf("dnsnames: %v, ips: %v\n", cfg.Hosts.GetDNSNames(), cfg.Hosts.GetIPs())
 	i := certmgmt.NewCertInfo(nil, nil, nil, nil)
-	n, err := certmgmt.UpdateCertificate(i, cfg)
+	n, err := certmgmt.UpdateCertificate(logger.New(), i, cfg)
 	if err != nil {
 		fmt.Printf("Initial creation failed: %s", err)
 		return
@@ -64,7 +64,7 @@ func CertsMain() {
 		return
 	}
 
-	r, err := certmgmt.UpdateCertificate(n, cfg)
+	r, err := certmgmt.UpdateCertificate(logger.New(), n, cfg)
 	if err != nil {
 		fmt.Printf("update failed: %s", err)
 		return
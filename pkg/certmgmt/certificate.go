@@ -21,17 +21,20 @@ import (
 	"time"
 
 	"k8s.io/client-go/util/cert"
-	"k8s.io/client-go/util/keyutil"
 
+	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 	"github.com/gardener/controller-manager-library/pkg/utils/pkiutil"
 )
 
 type info struct {
-	cert   []byte
-	key    []byte
-	cacert []byte
-	cakey  []byte
+	cert       []byte
+	key        []byte
+	cacert     []byte
+	cakey      []byte
+	cabundle   []byte
+	nextcacert []byte
+	nextcakey  []byte
 }
 
 func (this *info) Cert() []byte {
@@ -50,6 +53,21 @@ func (this *info) CAKey() []byte {
 	return this.cakey
 }
 
+func (this *info) CABundle() []byte {
+	if len(this.cabundle) == 0 {
+		return this.cacert
+	}
+	return this.cabundle
+}
+
+func (this *info) NextCACert() []byte {
+	return this.nextcacert
+}
+
+func (this *info) NextCAKey() []byte {
+	return this.nextcakey
+}
+
 func Equal(a CertificateInfo, b CertificateInfo) bool {
 	if a == b {
 		return true
@@ -61,7 +79,9 @@ func Equal(a CertificateInfo, b CertificateInfo) bool {
 		return false
 	}
 	return bytes.Equal(a.Cert(), b.Cert()) && bytes.Equal(a.Key(), b.Key()) &&
-		bytes.Equal(a.CACert(), b.CACert()) && bytes.Equal(a.CAKey(), b.CAKey())
+		bytes.Equal(a.CACert(), b.CACert()) && bytes.Equal(a.CAKey(), b.CAKey()) &&
+		bytes.Equal(a.CABundle(), b.CABundle()) &&
+		bytes.Equal(a.NextCACert(), b.NextCACert()) && bytes.Equal(a.NextCAKey(), b.NextCAKey())
 }
 
 func NewCertInfo(cert []byte, key []byte, cacert []byte, cakey []byte) CertificateInfo {
@@ -73,34 +93,40 @@ func NewCertInfo(cert []byte, key []byte, cacert []byte, cakey []byte) Certifica
 	}
 }
 
-func newPrivateKey() (*rsa.PrivateKey, error) {
-	return pkiutil.NewPrivateKey()
-}
-
-// encodePrivateKeyPEM returns PEM-encoded private key data
-func encodePrivateKeyPEM(key *rsa.PrivateKey) []byte {
-	block := pem.Block{
-		Type:  pkiutil.RSAPrivateKeyBlockType,
-		Bytes: x509.MarshalPKCS1PrivateKey(key),
+// NewCertInfoWithBundle creates a certificate info with a CA bundle and a
+// CA prepared for a rotation.
+func NewCertInfoWithBundle(cert, key, cacert, cakey, cabundle, nextcacert, nextcakey []byte) CertificateInfo {
+	return &info{
+		cert:       cert,
+		key:        key,
+		cacert:     cacert,
+		cakey:      cakey,
+		cabundle:   cabundle,
+		nextcacert: nextcacert,
+		nextcakey:  nextcakey,
 	}
-	return pem.EncodeToMemory(&block)
 }
 
-func UpdateCertificate(old CertificateInfo, cfg *Config) (CertificateInfo, error) {
+// UpdateCertificate checks a certificate info against a config and renews
+// the certificate and the CA certificates if required. The decisions are
+// reported with the given logger.
+func UpdateCertificate(logger logger.LogContext, old CertificateInfo, cfg *Config) (CertificateInfo, error) {
 	new := &info{}
 	if old != nil {
 		new.cert = old.Cert()
 		new.key = old.Key()
 		new.cacert = old.CACert()
 		new.cakey = old.CAKey()
+		new.cabundle = old.CABundle()
+		new.nextcacert = old.NextCACert()
+		new.nextcakey = old.NextCAKey()
 	}
 
-	var caKey *rsa.PrivateKey
+	var caKey crypto.Signer
 	var caCert *x509.Certificate
-	var newKey *rsa.PrivateKey
+	var newKey crypto.Signer
 	var newCert *x509.Certificate
 	var err error
-	var ok bool
 
 	if cfg == nil || cfg.ExternallyManaged {
 		valid, err := CheckInfo(new, 0, "")
@@ -110,7 +136,25 @@ func UpdateCertificate(old CertificateInfo, cfg *Config) (CertificateInfo, error
 		return new, nil
 	}
 
+	rotated, err := new.rotateCA(logger, cfg, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	valid := IsValidInfo(new, cfg.Rest, "")
+	if valid {
+		key, err := ParsePrivateKeyPEM(new.key)
+		valid = err == nil && MatchesKeyAlgorithm(key, cfg.KeyAlgorithm, cfg.KeySize)
+		if !valid {
+			logger.Infof("key does not match requested algorithm %s", cfg.KeyAlgorithm)
+		}
+	}
+	if valid && cfg.ClientUsage {
+		valid = hasClientUsage(new.cert)
+		if !valid {
+			logger.Infof("client usage missing")
+		}
+	}
 	if valid {
 		names := cfg.Hosts.GetDNSNames()
 		for _, ip := range cfg.Hosts.GetIPs() {
@@ -118,54 +162,32 @@ func UpdateCertificate(old CertificateInfo, cfg *Config) (CertificateInfo, error
 		}
 		valid = IsValidInfo(new, cfg.Rest, names...)
 		if !valid {
-			fmt.Printf("not valid for requested names: %v\n", names)
+			logger.Infof("certificate not valid for requested names: %v", names)
 		}
 	} else {
-		fmt.Printf("not valid\n")
+		logger.Infof("certificate not valid")
 	}
 	if !valid {
-		fmt.Printf("renew/create cert\n")
-		if new.cacert != nil {
-			fmt.Printf("cacert found\n")
-			ok = IsValid(new.cakey, new.cacert, new.cacert, 5*time.Hour*24, "")
-			if ok {
-				fmt.Printf("cacert valid\n")
-				k, err := keyutil.ParsePrivateKeyPEM(new.cakey)
-				if err != nil {
-					ok = false
-				} else {
-					caKey, ok = k.(*rsa.PrivateKey)
-				}
-				certs, err := cert.ParseCertsPEM(new.cacert)
-				if err != nil {
-					ok = false
-				} else {
-					caCert = certs[0]
-				}
-			}
-		}
-		if new.cacert == nil || !ok {
-			fmt.Printf("generate cacert\n")
-
-			caKey, err = newPrivateKey()
-			if err != nil {
-				return nil, fmt.Errorf("failed to create the CA key pair: %s", err)
-			}
-			new.cakey = encodePrivateKeyPEM(caKey)
-			caCert, err = cert.NewSelfSignedCACert(cert.Config{CommonName: "webhook-certmgmt-ca:" + cfg.CommonName}, caKey)
-			if err != nil {
-				return nil, fmt.Errorf("failed to create the CA certmgmt: %s", err)
-			}
-			new.cacert = pkiutil.EncodeCertPEM(caCert)
+		logger.Infof("renew/create certificate")
+		caKey, caCert, err = parseCA(new.cakey, new.cacert)
+		if err != nil {
+			return nil, err
 		}
 
-		fmt.Printf("generate key\n")
-		newKey, err = newPrivateKey()
+		logger.Infof("generate key")
+		newKey, err = NewPrivateKey(cfg.KeyAlgorithm, cfg.KeySize)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create the server key pair: %s", err)
 		}
-		new.key = encodePrivateKeyPEM(newKey)
-		fmt.Printf("generate certmgmt\n")
+		new.key, err = EncodePrivateKeyPEM(newKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the server key: %s", err)
+		}
+		logger.Infof("generate certificate")
+		usages := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		if cfg.ClientUsage {
+			usages = append(usages, x509.ExtKeyUsageClientAuth)
+		}
 		newCert, err = NewSignedCert(
 			&cert.Config{
 				CommonName:   cfg.CommonName,
@@ -174,7 +196,7 @@ func UpdateCertificate(old CertificateInfo, cfg *Config) (CertificateInfo, error
 					DNSNames: cfg.Hosts.GetDNSNames(),
 					IPs:      cfg.Hosts.GetIPs(),
 				},
-				Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+				Usages: usages,
 			},
 			newKey, caCert, caKey, cfg.Validity)
 		if err != nil {
@@ -183,9 +205,25 @@ func UpdateCertificate(old CertificateInfo, cfg *Config) (CertificateInfo, error
 		new.cert = pkiutil.EncodeCertPEM(newCert)
 		return new, nil
 	}
+	if rotated {
+		return new, nil
+	}
 	return old, nil
 }
 
+func hasClientUsage(data []byte) bool {
+	certs, err := cert.ParseCertsPEM(data)
+	if err != nil {
+		return false
+	}
+	for _, u := range certs[0].ExtKeyUsage {
+		if u == x509.ExtKeyUsageClientAuth {
+			return true
+		}
+	}
+	return false
+}
+
 func IsValidInfo(info CertificateInfo, duration time.Duration, name ...string) bool {
 	ok, _ := CheckInfo(info, duration, name...)
 	return ok
@@ -242,7 +280,7 @@ func Check(key []byte, cert []byte, cacert []byte, duration time.Duration, name
 		return false, err
 	}
 
-	block, _ := pem.Decode([]byte(cert))
+	block, rest := pem.Decode([]byte(cert))
 	if block == nil {
 		return false, fmt.Errorf("cannot decode certmgmt")
 	}
@@ -250,9 +288,16 @@ func Check(key []byte, cert []byte, cacert []byte, duration time.Duration, name
 	if err != nil {
 		return false, err
 	}
+	// further certificates are intermediate certificates of the chain
+	intermediates := x509.NewCertPool()
+	err = AppendCertsFromPEM(intermediates, rest)
+	if err != nil {
+		return false, err
+	}
 	ops := x509.VerifyOptions{
-		Roots:       pool,
-		CurrentTime: time.Now().Add(duration),
+		Roots:         pool,
+		Intermediates: intermediates,
+		CurrentTime:   time.Now().Add(duration),
 	}
 	for _, n := range name {
 		ops.DNSName = n
@@ -277,6 +322,11 @@ func NewSignedCert(cfg *cert.Config, key crypto.Signer, caCert *x509.Certificate
 		return nil, fmt.Errorf("must specify at least one ExtKeyUsage")
 	}
 
+	usage := x509.KeyUsageDigitalSignature
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		// key encipherment is only used for RSA key exchange
+		usage |= x509.KeyUsageKeyEncipherment
+	}
 	certTmpl := x509.Certificate{
 		Subject: pkix.Name{
 			CommonName:   cfg.CommonName,
@@ -287,7 +337,7 @@ func NewSignedCert(cfg *cert.Config, key crypto.Signer, caCert *x509.Certificate
 		SerialNumber: serial,
 		NotBefore:    caCert.NotBefore,
 		NotAfter:     time.Now().Add(duration).UTC(),
-		KeyUsage:     x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		KeyUsage:     usage,
 		ExtKeyUsage:  cfg.Usages,
 	}
 	certDERBytes, err := x509.CreateCertificate(cryptorand.Reader, &certTmpl, caCert, key.Public(), caKey)
@@ -18,7 +18,7 @@ func GetCertificateInfo(logger logger.LogContext, access CertificateAccess, cfg
 	if err != nil {
 		return nil, fmt.Errorf("error reading from certificate access: %s", err)
 	}
-	r, err = UpdateCertificate(r, cfg)
+	r, err = UpdateCertificate(logger, r, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("certmgmt update failed: %s", err)
 	}
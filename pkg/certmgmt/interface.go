@@ -7,6 +7,7 @@
 package certmgmt
 
 import (
+	"errors"
 	"time"
 
 	"github.com/gardener/controller-manager-library/pkg/logger"
@@ -19,6 +20,20 @@ type Config struct {
 	Validity          time.Duration
 	Rest              time.Duration
 	ExternallyManaged bool
+	// KeyAlgorithm is the algorithm used for generated keys (default RSA).
+	KeyAlgorithm KeyAlgorithm
+	// KeySize is the size of generated keys: the modulus size for RSA (default
+	// 3072) or the curve size for ECDSA (256 or 384, default 256).
+	KeySize int
+	// CARenewal is the remaining validity of the CA certificate starting its
+	// rotation (default 5 days). It should be larger than CAPropagationDelay.
+	CARenewal time.Duration
+	// CAPropagationDelay is the time a new CA certificate is published in the
+	// CA bundle before it is used to issue the serving certificate (default
+	// 1 hour).
+	CAPropagationDelay time.Duration
+	// ClientUsage requests certificates usable as client certificates, also.
+	ClientUsage bool
 }
 
 type CertificateInfo interface {
@@ -26,9 +41,35 @@ type CertificateInfo interface {
 	Key() []byte
 	CACert() []byte
 	CAKey() []byte
+	// CABundle returns the CA certificates to be trusted by clients: the CA
+	// issuing the serving certificate, a CA prepared for a rotation and
+	// previous CAs until they expire. If no bundle is maintained it
+	// is the CA certificate.
+	CABundle() []byte
+	// NextCACert returns the CA certificate prepared for a rotation.
+	NextCACert() []byte
+	// NextCAKey returns the key of the CA prepared for a rotation.
+	NextCAKey() []byte
 }
 
+// ErrConflict is returned by CertificateAccess.Set, if the stored
+// certificate info has been changed since it has been read by Get.
+var ErrConflict = errors.New("certificate info modified concurrently")
+
 type CertificateAccess interface {
 	Get(logger.LogContext) (CertificateInfo, error)
 	Set(logger.LogContext, CertificateInfo) error
 }
+
+// WatchableCertificateAccess is a CertificateAccess able to
+// notify about changes of the stored certificate info.
+type WatchableCertificateAccess interface {
+	CertificateAccess
+	Watch(logger logger.LogContext, notify func()) error
+}
+
+// CertificateEventRecorder may be implemented by a CertificateAccess
+// to record events for the stored certificate info.
+type CertificateEventRecorder interface {
+	Eventf(eventtype, reason, msgfmt string, args ...interface{})
+}
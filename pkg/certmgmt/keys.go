@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package certmgmt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/keyutil"
+
+	"github.com/gardener/controller-manager-library/pkg/utils/pkiutil"
+)
+
+// KeyAlgorithm is the algorithm used for generated private keys.
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmRSA     KeyAlgorithm = "RSA"
+	KeyAlgorithmECDSA   KeyAlgorithm = "ECDSA"
+	KeyAlgorithmEd25519 KeyAlgorithm = "Ed25519"
+)
+
+// KeyAlgorithms are the supported key algorithms.
+var KeyAlgorithms = []string{string(KeyAlgorithmRSA), string(KeyAlgorithmECDSA), string(KeyAlgorithmEd25519)}
+
+const (
+	// DefaultRSAKeySize is the key size used for RSA keys if no size is configured.
+	DefaultRSAKeySize = 3072
+	// DefaultECDSAKeySize is the curve size used for ECDSA keys if no size is configured.
+	DefaultECDSAKeySize = 256
+)
+
+// ParseKeyAlgorithm parses a key algorithm name (case-insensitive).
+// The empty string is mapped to RSA.
+func ParseKeyAlgorithm(name string) (KeyAlgorithm, error) {
+	if name == "" {
+		return KeyAlgorithmRSA, nil
+	}
+	for _, a := range KeyAlgorithms {
+		if strings.EqualFold(a, name) {
+			return KeyAlgorithm(a), nil
+		}
+	}
+	return "", fmt.Errorf("invalid key algorithm %q (possible values: %s)", name, strings.Join(KeyAlgorithms, ", "))
+}
+
+// keySize returns the effective key size for an algorithm and checks it.
+func keySize(algo KeyAlgorithm, size int) (int, error) {
+	switch algo {
+	case KeyAlgorithmRSA, "":
+		if size == 0 {
+			return DefaultRSAKeySize, nil
+		}
+		if size < 2048 {
+			return 0, fmt.Errorf("RSA key size must be at least 2048")
+		}
+	case KeyAlgorithmECDSA:
+		if size == 0 {
+			return DefaultECDSAKeySize, nil
+		}
+		if size != 256 && size != 384 {
+			return 0, fmt.Errorf("ECDSA key size must be 256 or 384")
+		}
+	case KeyAlgorithmEd25519:
+		if size != 0 {
+			return 0, fmt.Errorf("no key size possible for Ed25519")
+		}
+	default:
+		return 0, fmt.Errorf("invalid key algorithm %q", algo)
+	}
+	return size, nil
+}
+
+// NewPrivateKey creates a private key for the given algorithm and size.
+// A size of 0 selects the default size of the algorithm.
+func NewPrivateKey(algo KeyAlgorithm, size int) (crypto.Signer, error) {
+	size, err := keySize(algo, size)
+	if err != nil {
+		return nil, err
+	}
+	switch algo {
+	case KeyAlgorithmECDSA:
+		curve := elliptic.P256()
+		if size == 384 {
+			curve = elliptic.P384()
+		}
+		return ecdsa.GenerateKey(curve, cryptorand.Reader)
+	case KeyAlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(cryptorand.Reader)
+		return key, err
+	default:
+		return rsa.GenerateKey(cryptorand.Reader, size)
+	}
+}
+
+// MatchesKeyAlgorithm checks whether a key has been created for the given
+// algorithm and size. If no size is given, keys of any size match.
+func MatchesKeyAlgorithm(key crypto.Signer, algo KeyAlgorithm, size int) bool {
+	if _, err := keySize(algo, size); err != nil {
+		return false
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return (algo == KeyAlgorithmRSA || algo == "") && (size == 0 || k.N.BitLen() == size)
+	case *ecdsa.PrivateKey:
+		return algo == KeyAlgorithmECDSA && (size == 0 || k.Curve.Params().BitSize == size)
+	case ed25519.PrivateKey:
+		return algo == KeyAlgorithmEd25519
+	}
+	return false
+}
+
+// EncodePrivateKeyPEM returns the PEM-encoded PKCS8 form of a private key.
+func EncodePrivateKeyPEM(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: pkiutil.PrivateKeyBlockType, Bytes: der}), nil
+}
+
+// ParsePrivateKeyPEM parses a PEM-encoded RSA, ECDSA or Ed25519 private key
+// in PKCS8 form. RSA keys in PKCS1 form and ECDSA keys in SEC1 form are
+// accepted, also.
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	key, err := keyutil.ParsePrivateKeyPEM(data)
+	if err != nil {
+		return nil, err
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	}
+	return nil, fmt.Errorf("unsupported private key type %T", key)
+}
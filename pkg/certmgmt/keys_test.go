@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package certmgmt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Keys", func() {
+	DescribeTable("parses key algorithms",
+		func(name string, expected KeyAlgorithm, fails bool) {
+			algo, err := ParseKeyAlgorithm(name)
+			if fails {
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(algo).To(gomega.Equal(expected))
+		},
+		Entry("default", "", KeyAlgorithmRSA, false),
+		Entry("rsa", "rsa", KeyAlgorithmRSA, false),
+		Entry("ecdsa", "ECDSA", KeyAlgorithmECDSA, false),
+		Entry("ed25519", "ed25519", KeyAlgorithmEd25519, false),
+		Entry("invalid", "dsa", KeyAlgorithm(""), true),
+	)
+
+	DescribeTable("determines the key size",
+		func(algo KeyAlgorithm, size, expected int, fails bool) {
+			s, err := keySize(algo, size)
+			if fails {
+				gomega.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(s).To(gomega.Equal(expected))
+		},
+		Entry("RSA default", KeyAlgorithmRSA, 0, DefaultRSAKeySize, false),
+		Entry("empty algorithm", KeyAlgorithm(""), 0, DefaultRSAKeySize, false),
+		Entry("RSA 4096", KeyAlgorithmRSA, 4096, 4096, false),
+		Entry("RSA too small", KeyAlgorithmRSA, 1024, 0, true),
+		Entry("ECDSA default", KeyAlgorithmECDSA, 0, DefaultECDSAKeySize, false),
+		Entry("ECDSA 384", KeyAlgorithmECDSA, 384, 384, false),
+		Entry("ECDSA invalid curve", KeyAlgorithmECDSA, 521, 0, true),
+		Entry("Ed25519", KeyAlgorithmEd25519, 0, 0, false),
+		Entry("Ed25519 with size", KeyAlgorithmEd25519, 256, 0, true),
+		Entry("invalid algorithm", KeyAlgorithm("DSA"), 0, 0, true),
+	)
+
+	DescribeTable("creates, encodes and parses private keys",
+		func(algo KeyAlgorithm, size int, check func(crypto.Signer)) {
+			key, err := NewPrivateKey(algo, size)
+			gomega.Expect(err).To(gomega.Succeed())
+			check(key)
+			gomega.Expect(MatchesKeyAlgorithm(key, algo, size)).To(gomega.BeTrue())
+
+			data, err := EncodePrivateKeyPEM(key)
+			gomega.Expect(err).To(gomega.Succeed())
+			block, _ := pem.Decode(data)
+			gomega.Expect(block).NotTo(gomega.BeNil())
+			gomega.Expect(block.Type).To(gomega.Equal("PRIVATE KEY"))
+
+			parsed, err := ParsePrivateKeyPEM(data)
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(parsed).To(gomega.Equal(key))
+		},
+		Entry("RSA", KeyAlgorithmRSA, 2048, func(key crypto.Signer) {
+			gomega.Expect(key).To(gomega.BeAssignableToTypeOf(&rsa.PrivateKey{}))
+			gomega.Expect(key.(*rsa.PrivateKey).N.BitLen()).To(gomega.Equal(2048))
+		}),
+		Entry("ECDSA P-256", KeyAlgorithmECDSA, 0, func(key crypto.Signer) {
+			gomega.Expect(key).To(gomega.BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			gomega.Expect(key.(*ecdsa.PrivateKey).Curve).To(gomega.Equal(elliptic.P256()))
+		}),
+		Entry("ECDSA P-384", KeyAlgorithmECDSA, 384, func(key crypto.Signer) {
+			gomega.Expect(key).To(gomega.BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			gomega.Expect(key.(*ecdsa.PrivateKey).Curve).To(gomega.Equal(elliptic.P384()))
+		}),
+		Entry("Ed25519", KeyAlgorithmEd25519, 0, func(key crypto.Signer) {
+			gomega.Expect(key).To(gomega.BeAssignableToTypeOf(ed25519.PrivateKey{}))
+		}),
+	)
+
+	It("rejects invalid key sizes", func() {
+		_, err := NewPrivateKey(KeyAlgorithmRSA, 1024)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+		_, err = NewPrivateKey(KeyAlgorithmEd25519, 256)
+		gomega.Expect(err).To(gomega.HaveOccurred())
+	})
+
+	Context("legacy formats", func() {
+		It("parses PKCS1 RSA keys", func() {
+			key, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+			gomega.Expect(err).To(gomega.Succeed())
+			data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+			parsed, err := ParsePrivateKeyPEM(data)
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(parsed).To(gomega.Equal(key))
+
+			// re-encoded as PKCS8
+			data, err = EncodePrivateKeyPEM(parsed)
+			gomega.Expect(err).To(gomega.Succeed())
+			parsed, err = ParsePrivateKeyPEM(data)
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(parsed).To(gomega.Equal(key))
+		})
+
+		It("parses SEC1 ECDSA keys", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+			gomega.Expect(err).To(gomega.Succeed())
+			der, err := x509.MarshalECPrivateKey(key)
+			gomega.Expect(err).To(gomega.Succeed())
+			data := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+
+			parsed, err := ParsePrivateKeyPEM(data)
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(parsed).To(gomega.Equal(key))
+		})
+
+		It("rejects invalid data", func() {
+			_, err := ParsePrivateKeyPEM([]byte("no key"))
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+	})
+
+	Context("matching", func() {
+		var (
+			rsaKey   crypto.Signer
+			ecdsaKey crypto.Signer
+			edKey    crypto.Signer
+		)
+
+		BeforeEach(func() {
+			var err error
+			rsaKey, err = rsa.GenerateKey(cryptorand.Reader, 2048)
+			gomega.Expect(err).To(gomega.Succeed())
+			ecdsaKey, err = ecdsa.GenerateKey(elliptic.P384(), cryptorand.Reader)
+			gomega.Expect(err).To(gomega.Succeed())
+			_, edKey, err = ed25519.GenerateKey(cryptorand.Reader)
+			gomega.Expect(err).To(gomega.Succeed())
+		})
+
+		DescribeTable("matches keys against algorithm and size",
+			func(key func() crypto.Signer, algo KeyAlgorithm, size int, expected bool) {
+				gomega.Expect(MatchesKeyAlgorithm(key(), algo, size)).To(gomega.Equal(expected))
+			},
+			Entry("RSA any size", func() crypto.Signer { return rsaKey }, KeyAlgorithmRSA, 0, true),
+			Entry("RSA for empty algorithm", func() crypto.Signer { return rsaKey }, KeyAlgorithm(""), 0, true),
+			Entry("RSA same size", func() crypto.Signer { return rsaKey }, KeyAlgorithmRSA, 2048, true),
+			Entry("RSA other size", func() crypto.Signer { return rsaKey }, KeyAlgorithmRSA, 4096, false),
+			Entry("RSA invalid size", func() crypto.Signer { return rsaKey }, KeyAlgorithmRSA, 1024, false),
+			Entry("RSA for ECDSA", func() crypto.Signer { return rsaKey }, KeyAlgorithmECDSA, 0, false),
+			Entry("ECDSA any size", func() crypto.Signer { return ecdsaKey }, KeyAlgorithmECDSA, 0, true),
+			Entry("ECDSA same size", func() crypto.Signer { return ecdsaKey }, KeyAlgorithmECDSA, 384, true),
+			Entry("ECDSA other size", func() crypto.Signer { return ecdsaKey }, KeyAlgorithmECDSA, 256, false),
+			Entry("ECDSA for RSA", func() crypto.Signer { return ecdsaKey }, KeyAlgorithmRSA, 0, false),
+			Entry("Ed25519", func() crypto.Signer { return edKey }, KeyAlgorithmEd25519, 0, true),
+			Entry("Ed25519 for RSA", func() crypto.Signer { return edKey }, KeyAlgorithmRSA, 0, false),
+		)
+	})
+})
@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package certmgmt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/util/cert"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/utils/pkiutil"
+)
+
+// DefaultCARenewal is the remaining validity of a CA certificate
+// starting its rotation if no renewal time is configured.
+const DefaultCARenewal = 5 * 24 * time.Hour
+
+// DefaultCAPropagationDelay is the time a new CA certificate is published
+// before it is used if no propagation delay is configured.
+const DefaultCAPropagationDelay = time.Hour
+
+// rotateCA maintains the CA certificates of a managed certificate.
+// The rotation is done in stages to give clients the chance to learn the
+// new CA before it is used:
+//   - if the CA nears its expiration, a new CA is generated and published
+//     in the CA bundle together with the actual one,
+//   - after the propagation delay (counted from the creation of the new CA)
+//     the new CA replaces the actual one and is used for the serving
+//     certificate,
+//   - previous CAs are kept in the bundle until they expire.
+//
+// If there is no usable CA, a prepared one is used or a new one is generated
+// immediately. It reports whether the CA settings have been changed.
+func (this *info) rotateCA(logger logger.LogContext, cfg *Config, now time.Time) (bool, error) {
+	renewal := cfg.CARenewal
+	if renewal <= 0 {
+		renewal = DefaultCARenewal
+	}
+	delay := cfg.CAPropagationDelay
+	if delay <= 0 {
+		delay = DefaultCAPropagationDelay
+	}
+	changed := false
+
+	if len(this.nextcacert) > 0 && !isValidCA(this.nextcakey, this.nextcacert, 0) {
+		logger.Infof("drop invalid next CA certificate")
+		this.nextcacert, this.nextcakey = nil, nil
+		changed = true
+	}
+	if !isValidCA(this.cakey, this.cacert, 0) {
+		if len(this.nextcacert) > 0 {
+			logger.Infof("CA certificate not valid: use next CA certificate")
+			this.promoteCA()
+		} else {
+			logger.Infof("generate CA certificate")
+			key, cacert, err := newCA(cfg)
+			if err != nil {
+				return false, err
+			}
+			this.cakey, this.cacert = key, cacert
+		}
+		changed = true
+	}
+	if len(this.nextcacert) == 0 && !isValidCA(this.cakey, this.cacert, renewal) {
+		logger.Infof("CA certificate expires soon: generate next CA certificate")
+		key, cacert, err := newCA(cfg)
+		if err != nil {
+			return false, err
+		}
+		this.nextcakey, this.nextcacert = key, cacert
+		changed = true
+	}
+	if len(this.nextcacert) > 0 {
+		_, next, err := parseCA(this.nextcakey, this.nextcacert)
+		if err != nil {
+			return false, err
+		}
+		if !now.Before(next.NotBefore.Add(delay)) {
+			logger.Infof("propagation delay expired: use next CA certificate")
+			this.promoteCA()
+			changed = true
+		}
+	}
+
+	bundle := caBundle(now, this.cacert, this.nextcacert, this.cabundle)
+	if !bytes.Equal(bundle, this.CABundle()) {
+		changed = true
+	}
+	if bytes.Equal(bundle, this.cacert) {
+		this.cabundle = nil
+	} else {
+		this.cabundle = bundle
+	}
+	return changed, nil
+}
+
+func (this *info) promoteCA() {
+	this.cacert, this.cakey = this.nextcacert, this.nextcakey
+	this.nextcacert, this.nextcakey = nil, nil
+}
+
+func newCA(cfg *Config) ([]byte, []byte, error) {
+	caKey, err := NewPrivateKey(cfg.KeyAlgorithm, cfg.KeySize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the CA key pair: %s", err)
+	}
+	key, err := EncodePrivateKeyPEM(caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode the CA key: %s", err)
+	}
+	caCert, err := cert.NewSelfSignedCACert(cert.Config{CommonName: "webhook-certmgmt-ca:" + cfg.CommonName}, caKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the CA certmgmt: %s", err)
+	}
+	return key, pkiutil.EncodeCertPEM(caCert), nil
+}
+
+func parseCA(key []byte, cacert []byte) (crypto.Signer, *x509.Certificate, error) {
+	caKey, err := ParsePrivateKeyPEM(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CA key: %s", err)
+	}
+	certs, err := cert.ParseCertsPEM(cacert)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CA certmgmt: %s", err)
+	}
+	return caKey, certs[0], nil
+}
+
+func isValidCA(key []byte, cacert []byte, duration time.Duration) bool {
+	if len(key) == 0 || !IsValid(key, cacert, cacert, duration, "") {
+		return false
+	}
+	_, _, err := parseCA(key, cacert)
+	return err == nil
+}
+
+// caBundle composes the CA bundle from the actual CA, the next CA and the
+// certificates of a previous bundle, which are not yet expired.
+func caBundle(now time.Time, cacert []byte, nextcacert []byte, previous []byte) []byte {
+	var bundle []byte
+	found := map[string]bool{}
+	add := func(data []byte, check bool) {
+		for len(data) > 0 {
+			var block *pem.Block
+			block, data = pem.Decode(data)
+			if block == nil {
+				return
+			}
+			if block.Type != "CERTIFICATE" || found[string(block.Bytes)] {
+				continue
+			}
+			if check {
+				c, err := x509.ParseCertificate(block.Bytes)
+				if err != nil || now.After(c.NotAfter) {
+					continue
+				}
+			}
+			found[string(block.Bytes)] = true
+			bundle = append(bundle, pem.EncodeToMemory(block)...)
+		}
+	}
+	add(cacert, false)
+	add(nextcacert, false)
+	add(previous, true)
+	return bundle
+}
@@ -0,0 +1,217 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package certmgmt
+
+import (
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// certsOf returns the certificate blocks of PEM data.
+func certsOf(data []byte) []string {
+	var result []string
+	for len(data) > 0 {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			result = append(result, string(pem.EncodeToMemory(block)))
+		}
+	}
+	return result
+}
+
+var _ = Describe("CA rotation", func() {
+	const years = 365 * 24 * time.Hour
+
+	type ca struct {
+		key  []byte
+		cert []byte
+	}
+
+	var (
+		cfg     *Config
+		current ca
+		next    ca
+		old     ca
+	)
+
+	newTestCA := func() ca {
+		key, cert, err := newCA(cfg)
+		gomega.Expect(err).To(gomega.Succeed())
+		return ca{key, cert}
+	}
+
+	BeforeEach(func() {
+		cfg = &Config{CommonName: "test", KeyAlgorithm: KeyAlgorithmECDSA}
+		current = newTestCA()
+		next = newTestCA()
+		old = newTestCA()
+	})
+
+	type stage struct {
+		// setup prepares the certificate info and the config
+		setup func() *info
+		// now is the offset to the actual time used for the rotation
+		now     time.Duration
+		changed bool
+		check   func(i *info)
+	}
+
+	bundle := func(cas ...ca) []byte {
+		var data []byte
+		for _, c := range cas {
+			data = append(data, c.cert...)
+		}
+		return data
+	}
+
+	DescribeTable("rotates the CA in stages",
+		func(s stage) {
+			i := s.setup()
+			changed, err := i.rotateCA(logger.New(), cfg, time.Now().Add(s.now))
+			gomega.Expect(err).To(gomega.Succeed())
+			gomega.Expect(changed).To(gomega.Equal(s.changed))
+			s.check(i)
+		},
+		Entry("creates a missing CA", stage{
+			setup:   func() *info { return &info{} },
+			changed: true,
+			check: func(i *info) {
+				gomega.Expect(isValidCA(i.cakey, i.cacert, 0)).To(gomega.BeTrue())
+				gomega.Expect(i.nextcacert).To(gomega.BeEmpty())
+				gomega.Expect(i.cabundle).To(gomega.BeNil())
+				gomega.Expect(i.CABundle()).To(gomega.Equal(i.cacert))
+			},
+		}),
+		Entry("keeps a valid CA", stage{
+			setup: func() *info { return &info{cakey: current.key, cacert: current.cert} },
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(current.cert))
+				gomega.Expect(i.nextcacert).To(gomega.BeEmpty())
+				gomega.Expect(i.cabundle).To(gomega.BeNil())
+			},
+		}),
+		Entry("prepares the next CA when the CA expires soon", stage{
+			setup: func() *info {
+				cfg.CARenewal = 11 * years
+				return &info{cakey: current.key, cacert: current.cert}
+			},
+			changed: true,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(current.cert))
+				gomega.Expect(isValidCA(i.nextcakey, i.nextcacert, 0)).To(gomega.BeTrue())
+				gomega.Expect(certsOf(i.cabundle)).To(gomega.Equal(certsOf(bundle(current, ca{cert: i.nextcacert}))))
+			},
+		}),
+		Entry("keeps the next CA during the propagation delay", stage{
+			setup: func() *info {
+				cfg.CARenewal = 11 * years
+				cfg.CAPropagationDelay = 2 * time.Hour
+				return &info{cakey: current.key, cacert: current.cert, nextcakey: next.key, nextcacert: next.cert, cabundle: bundle(current, next)}
+			},
+			now: time.Hour,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(current.cert))
+				gomega.Expect(i.nextcacert).To(gomega.Equal(next.cert))
+			},
+		}),
+		Entry("uses the next CA after the propagation delay", stage{
+			setup: func() *info {
+				cfg.CARenewal = 11 * years
+				cfg.CAPropagationDelay = 2 * time.Hour
+				return &info{cakey: current.key, cacert: current.cert, nextcakey: next.key, nextcacert: next.cert, cabundle: bundle(current, next)}
+			},
+			now:     3 * time.Hour,
+			changed: true,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(next.cert))
+				gomega.Expect(i.cakey).To(gomega.Equal(next.key))
+				gomega.Expect(i.nextcacert).To(gomega.BeEmpty())
+				gomega.Expect(certsOf(i.cabundle)).To(gomega.Equal(certsOf(bundle(next, current))))
+			},
+		}),
+		Entry("uses the default propagation delay for 0", stage{
+			setup: func() *info {
+				return &info{cakey: current.key, cacert: current.cert, nextcakey: next.key, nextcacert: next.cert, cabundle: bundle(current, next)}
+			},
+			now: DefaultCAPropagationDelay / 2,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(current.cert))
+				gomega.Expect(i.nextcacert).To(gomega.Equal(next.cert))
+			},
+		}),
+		Entry("uses the next CA after the default propagation delay", stage{
+			setup: func() *info {
+				return &info{cakey: current.key, cacert: current.cert, nextcakey: next.key, nextcacert: next.cert, cabundle: bundle(current, next)}
+			},
+			now:     DefaultCAPropagationDelay + time.Minute,
+			changed: true,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(next.cert))
+				gomega.Expect(i.nextcacert).To(gomega.BeEmpty())
+			},
+		}),
+		Entry("uses the next CA immediately if the CA is invalid", stage{
+			setup: func() *info {
+				return &info{cacert: []byte("invalid"), nextcakey: next.key, nextcacert: next.cert}
+			},
+			changed: true,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(next.cert))
+				gomega.Expect(i.nextcacert).To(gomega.BeEmpty())
+				gomega.Expect(i.cabundle).To(gomega.BeNil())
+			},
+		}),
+		Entry("drops an invalid next CA", stage{
+			setup: func() *info {
+				return &info{cakey: current.key, cacert: current.cert, nextcakey: current.key, nextcacert: []byte("invalid"), cabundle: current.cert}
+			},
+			changed: true,
+			check: func(i *info) {
+				gomega.Expect(i.cacert).To(gomega.Equal(current.cert))
+				gomega.Expect(i.nextcacert).To(gomega.BeNil())
+				gomega.Expect(i.nextcakey).To(gomega.BeNil())
+				gomega.Expect(i.cabundle).To(gomega.BeNil())
+			},
+		}),
+	)
+
+	DescribeTable("prunes the CA bundle",
+		func(now time.Duration, withNext bool, previous func() []byte, expected func() []ca) {
+			nextcert := []byte(nil)
+			if withNext {
+				nextcert = next.cert
+			}
+			result := caBundle(time.Now().Add(now), current.cert, nextcert, previous())
+			gomega.Expect(certsOf(result)).To(gomega.Equal(certsOf(bundle(expected()...))))
+		},
+		Entry("without previous bundle", time.Duration(0), true,
+			func() []byte { return nil },
+			func() []ca { return []ca{current, next} }),
+		Entry("keeps valid previous CAs", time.Duration(0), true,
+			func() []byte { return bundle(old, current) },
+			func() []ca { return []ca{current, next, old} }),
+		Entry("drops expired previous CAs", 11*years, true,
+			func() []byte { return bundle(old, current) },
+			func() []ca { return []ca{current, next} }),
+		Entry("removes duplicates", time.Duration(0), false,
+			func() []byte { return bundle(current, old, old) },
+			func() []ca { return []ca{current, old} }),
+		Entry("skips other blocks", time.Duration(0), false,
+			func() []byte { return append(append([]byte{}, old.key...), old.cert...) },
+			func() []ca { return []ca{current, old} }),
+	)
+})
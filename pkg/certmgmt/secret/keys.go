@@ -16,6 +16,12 @@ const (
 	KeyName = "key.pem"
 	// CertName is the name of the serving certificate
 	CertName = "certmgmt.pem"
+	// CABundleName is the name of the CA bundle
+	CABundleName = "ca-bundle.pem"
+	// NextCAKeyName is the name of the private key of the CA prepared for a rotation
+	NextCAKeyName = "next-ca-key.pem"
+	// NextCACertName is the name of the certificate of the CA prepared for a rotation
+	NextCACertName = "next-ca-certmgmt.pem"
 )
 
 type Keys struct {
@@ -23,6 +29,10 @@ type Keys struct {
 	CACertName string
 	KeyName    string
 	CertName   string
+
+	CABundleName   string
+	NextCAKeyName  string
+	NextCACertName string
 }
 
 func TLSKeys() Keys {
@@ -31,6 +41,10 @@ func TLSKeys() Keys {
 		CACertName: "ca.crt",
 		KeyName:    "tls.key",
 		CertName:   "tls.crt",
+
+		CABundleName:   "ca-bundle.crt",
+		NextCAKeyName:  "next-ca.key",
+		NextCACertName: "next-ca.crt",
 	}
 }
 
@@ -40,5 +54,9 @@ func DefaultKeys() Keys {
 		CACertName: CACertName,
 		KeyName:    KeyName,
 		CertName:   CertName,
+
+		CABundleName:   CABundleName,
+		NextCAKeyName:  NextCAKeyName,
+		NextCACertName: NextCACertName,
 	}
 }
@@ -7,31 +7,40 @@
 package secret
 
 import (
+	"bytes"
 	"fmt"
+	"sync"
 
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/gardener/controller-manager-library/pkg/certmgmt"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
-	"github.com/gardener/controller-manager-library/pkg/fieldpath"
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 ////////////////////////////////////////////////////////////////////////////////
 
-var dataField = fieldpath.RequiredField(&corev1.Secret{}, ".Data")
+var secretGroupKind = schema.GroupKind{Group: corev1.GroupName, Kind: "Secret"}
 
 type secretCertificateAccess struct {
 	cluster cluster.Interface
 	name    resources.ObjectName
 	keys    []Keys
+
+	lock sync.Mutex
+	// read is the secret read by the last Get (nil if not found). It is used
+	// as precondition for the next Set.
+	read *corev1.Secret
 }
 
-var _ certmgmt.CertificateAccess = &secretCertificateAccess{}
+var _ certmgmt.WatchableCertificateAccess = &secretCertificateAccess{}
+var _ certmgmt.CertificateEventRecorder = &secretCertificateAccess{}
 
 func NewSecret(cluster cluster.Interface, name resources.ObjectName, keys ...Keys) certmgmt.CertificateAccess {
 	if len(keys) == 0 {
@@ -49,6 +58,10 @@ func (this *secretCertificateAccess) String() string {
 }
 
 func (this *secretCertificateAccess) Get(_ logger.LogContext) (certmgmt.CertificateInfo, error) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.read = nil
 	secret, err := resources.GetSecret(this.cluster, this.name.Namespace(), this.name.Name())
 	if err != nil {
 		if !apierrors.IsNotFound(err) {
@@ -56,20 +69,88 @@ func (this *secretCertificateAccess) Get(_ logger.LogContext) (certmgmt.Certific
 		}
 		return nil, nil
 	}
+	this.read = secret.Secret()
 	return dataToCertInfo(secret.GetData(), this.keys), nil
 }
 
+// Set writes the certificate info, if the secret has not been changed since
+// the last Get. Otherwise certmgmt.ErrConflict is returned.
 func (this *secretCertificateAccess) Set(logger logger.LogContext, cert certmgmt.CertificateInfo) error {
-	r, _ := this.cluster.GetResource(schema.GroupKind{Group: corev1.GroupName, Kind: "Secret"})
-	o := r.New(this.name)
-	data := certInfoToData(cert, this.keys[0])
-	mod, err := resources.CreateOrModify(o, func(mod *resources.ModificationState) error {
-		return mod.Set(dataField, data)
-	})
-	if mod {
-		logger.Infof("certs in secret %q[%s] are updated", this.name, this.cluster.GetName())
-	}
-	return err
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	r, err := this.cluster.GetResource(secretGroupKind)
+	if err != nil {
+		return err
+	}
+	var secret *corev1.Secret
+	if this.read == nil {
+		secret = &corev1.Secret{}
+		secret.SetName(this.name.Name())
+		secret.SetNamespace(this.name.Namespace())
+	} else {
+		// the resource version of the read secret is the precondition for the update
+		secret = this.read.DeepCopy()
+	}
+	secret.Data = certInfoToData(cert, this.keys[0])
+
+	var o resources.Object
+	if this.read == nil {
+		o, err = r.Create(secret)
+	} else {
+		o, err = r.Update(secret)
+	}
+	if err != nil {
+		if apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) {
+			return certmgmt.ErrConflict
+		}
+		return err
+	}
+	this.read = nil
+	if s := resources.Secret(o); s != nil {
+		this.read = s.Secret()
+	}
+	logger.Infof("certs in secret %q[%s] are updated", this.name, this.cluster.GetName())
+	return nil
+}
+
+// Eventf records an event for the secret.
+func (this *secretCertificateAccess) Eventf(eventtype, reason, msgfmt string, args ...interface{}) {
+	this.lock.Lock()
+	secret := this.read
+	this.lock.Unlock()
+
+	r, err := this.cluster.GetResource(secretGroupKind)
+	if err != nil {
+		return
+	}
+	var o resources.Object
+	if secret != nil {
+		o, err = r.Wrap(secret)
+		if err != nil {
+			return
+		}
+	} else {
+		o = r.New(this.name)
+	}
+	o.Eventf(eventtype, reason, msgfmt, args...)
+}
+
+// Watch calls the notification function for changes of the secret.
+func (this *secretCertificateAccess) Watch(logger logger.LogContext, notify func()) error {
+	r, err := this.cluster.GetResource(secretGroupKind)
+	if err != nil {
+		return err
+	}
+	selector := func(opts *metav1.ListOptions) {
+		opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", this.name.Name()).String()
+	}
+	logger.Infof("watching secret %q[%s]", this.name, this.cluster.GetName())
+	return r.AddSelectedEventHandler(resources.ResourceEventHandlerFuncs{
+		AddFunc:    func(resources.Object) { notify() },
+		UpdateFunc: func(resources.Object, resources.Object) { notify() },
+		DeleteFunc: func(resources.Object) { notify() },
+	}, this.name.Namespace(), selector)
 }
 
 func dataToCertInfo(data map[string][]byte, keys []Keys) certmgmt.CertificateInfo {
@@ -110,7 +191,31 @@ func dataToCertInfo(data map[string][]byte, keys []Keys) certmgmt.CertificateInf
 		}
 	}
 
-	return certmgmt.NewCertInfo(_cert, _key, _cacert, _cakey)
+	var _cabundle []byte
+	for _, k := range keys {
+		_cabundle, ok = data[k.CABundleName]
+		if ok {
+			break
+		}
+	}
+
+	var _nextcacert []byte
+	for _, k := range keys {
+		_nextcacert, ok = data[k.NextCACertName]
+		if ok {
+			break
+		}
+	}
+
+	var _nextcakey []byte
+	for _, k := range keys {
+		_nextcakey, ok = data[k.NextCAKeyName]
+		if ok {
+			break
+		}
+	}
+
+	return certmgmt.NewCertInfoWithBundle(_cert, _key, _cacert, _cakey, _cabundle, _nextcacert, _nextcakey)
 }
 
 func certInfoToData(cert certmgmt.CertificateInfo, keys Keys) map[string][]byte {
@@ -119,11 +224,16 @@ func certInfoToData(cert certmgmt.CertificateInfo, keys Keys) map[string][]byte
 	add(m, keys.CAKeyName, cert.CAKey())
 	add(m, keys.CertName, cert.Cert())
 	add(m, keys.KeyName, cert.Key())
+	if !bytes.Equal(cert.CABundle(), cert.CACert()) {
+		add(m, keys.CABundleName, cert.CABundle())
+	}
+	add(m, keys.NextCACertName, cert.NextCACert())
+	add(m, keys.NextCAKeyName, cert.NextCAKey())
 	return m
 }
 
 func add(m map[string][]byte, key string, data []byte) {
-	if len(data) > 0 {
+	if key != "" && len(data) > 0 {
 		m[key] = data
 	}
 }
@@ -0,0 +1,20 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package secret
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecretSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Secret Suite")
+}
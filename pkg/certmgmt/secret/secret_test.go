@@ -0,0 +1,310 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+const secretsPath = "/api/v1/namespaces/default/secrets"
+
+// secretServer is a minimal kube-apiserver keeping secrets of the
+// namespace default in memory. Updates are checked against the
+// resource version like by a real apiserver.
+type secretServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	version  int
+	secrets  map[string]*corev1.Secret
+	watchers []chan watch.Event
+}
+
+func newSecretServer() *secretServer {
+	this := &secretServer{secrets: map[string]*corev1.Secret{}}
+	this.Server = httptest.NewServer(http.HandlerFunc(this.serve))
+	return this
+}
+
+func (this *secretServer) Close() {
+	this.lock.Lock()
+	for _, w := range this.watchers {
+		close(w)
+	}
+	this.watchers = nil
+	this.lock.Unlock()
+	this.Server.CloseClientConnections()
+	this.Server.Close()
+}
+
+// store stores a secret as done by another client.
+func (this *secretServer) store(name string, data map[string][]byte) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}, Data: data}
+	event := watch.Added
+	if old := this.secrets[name]; old != nil {
+		secret.UID = old.UID
+		event = watch.Modified
+	}
+	this.put(event, secret)
+}
+
+func (this *secretServer) get(name string) *corev1.Secret {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return this.secrets[name]
+}
+
+func (this *secretServer) put(event watch.EventType, secret *corev1.Secret) {
+	this.version++
+	secret.ResourceVersion = strconv.Itoa(this.version)
+	this.secrets[secret.Name] = secret
+	for _, w := range this.watchers {
+		w <- watch.Event{Type: event, Object: secret.DeepCopy()}
+	}
+}
+
+func (this *secretServer) serve(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case req.URL.Path == "/version":
+		this.write(w, http.StatusOK, map[string]string{"gitVersion": "v1.34.1"})
+	case req.URL.Path == "/api":
+		this.write(w, http.StatusOK, metav1.APIVersions{Versions: []string{"v1"}})
+	case req.URL.Path == "/apis":
+		this.write(w, http.StatusOK, metav1.APIGroupList{})
+	case req.URL.Path == "/api/v1":
+		this.write(w, http.StatusOK, metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: metav1.Verbs{"get", "list", "watch", "create", "update"}},
+				{Name: "events", Namespaced: true, Kind: "Event", Verbs: metav1.Verbs{"create"}},
+			},
+		})
+	case req.URL.Path == secretsPath && req.Method == http.MethodGet:
+		if req.URL.Query().Get("watch") == "true" {
+			this.watch(w, req)
+		} else {
+			this.list(w)
+		}
+	case req.URL.Path == secretsPath && req.Method == http.MethodPost:
+		this.create(w, req)
+	case strings.HasPrefix(req.URL.Path, secretsPath+"/"):
+		name := strings.TrimPrefix(req.URL.Path, secretsPath+"/")
+		switch req.Method {
+		case http.MethodGet:
+			if s := this.get(name); s != nil {
+				this.write(w, http.StatusOK, s)
+			} else {
+				this.status(w, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name))
+			}
+		case http.MethodPut:
+			this.update(w, req, name)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (this *secretServer) write(w http.ResponseWriter, code int, obj interface{}) {
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+func (this *secretServer) status(w http.ResponseWriter, err *apierrors.StatusError) {
+	status := err.Status()
+	status.Kind, status.APIVersion = "Status", "v1"
+	this.write(w, int(status.Code), status)
+}
+
+func (this *secretServer) decode(req *http.Request) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	return secret, json.NewDecoder(req.Body).Decode(secret)
+}
+
+func (this *secretServer) list(w http.ResponseWriter) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	list := &corev1.SecretList{TypeMeta: metav1.TypeMeta{Kind: "SecretList", APIVersion: "v1"}}
+	list.ResourceVersion = strconv.Itoa(this.version)
+	for _, s := range this.secrets {
+		list.Items = append(list.Items, *s)
+	}
+	this.write(w, http.StatusOK, list)
+}
+
+func (this *secretServer) create(w http.ResponseWriter, req *http.Request) {
+	secret, err := this.decode(req)
+	if err != nil {
+		this.status(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if this.secrets[secret.Name] != nil {
+		this.status(w, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "secrets"}, secret.Name))
+		return
+	}
+	this.put(watch.Added, secret)
+	this.write(w, http.StatusCreated, secret)
+}
+
+func (this *secretServer) update(w http.ResponseWriter, req *http.Request, name string) {
+	secret, err := this.decode(req)
+	if err != nil {
+		this.status(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	old := this.secrets[name]
+	if old == nil {
+		this.status(w, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name))
+		return
+	}
+	if secret.ResourceVersion != old.ResourceVersion {
+		this.status(w, apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, name, nil))
+		return
+	}
+	this.put(watch.Modified, secret)
+	this.write(w, http.StatusOK, secret)
+}
+
+func (this *secretServer) watch(w http.ResponseWriter, req *http.Request) {
+	events := make(chan watch.Event, 100)
+	this.lock.Lock()
+	this.watchers = append(this.watchers, events)
+	this.lock.Unlock()
+
+	w.WriteHeader(http.StatusOK)
+	w.(http.Flusher).Flush()
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			data, _ := json.Marshal(e.Object)
+			_ = encoder.Encode(metav1.WatchEvent{Type: string(e.Type), Object: runtime.RawExtension{Raw: data}})
+			w.(http.Flusher).Flush()
+		}
+	}
+}
+
+var _ = Describe("secret certificate access", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		server *secretServer
+		access certmgmt.CertificateAccess
+		log    logger.LogContext
+	)
+
+	info := func(cert string) certmgmt.CertificateInfo {
+		return certmgmt.NewCertInfo([]byte(cert), []byte("key"), []byte("cacert"), []byte("cakey"))
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		log = logger.New()
+		server = newSecretServer()
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		def := cluster.Configure("test", "", "test cluster").Scheme(scheme).Definition()
+		c, err := cluster.CreateClusterForScheme(ctx, log, def, "test-id", &restclient.Config{Host: server.URL}, nil)
+		Expect(err).To(Succeed())
+		access = NewSecret(c, resources.NewObjectName("default", "cert"))
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	It("creates and updates the secret", func() {
+		i, err := access.Get(log)
+		Expect(err).To(Succeed())
+		Expect(i).To(BeNil())
+		Expect(access.Set(log, info("first"))).To(Succeed())
+		Expect(server.get("cert").Data).To(HaveKeyWithValue(DefaultKeys().CertName, []byte("first")))
+
+		// the secret written last is the precondition for the next update
+		Expect(access.Set(log, info("second"))).To(Succeed())
+		Expect(server.get("cert").Data).To(HaveKeyWithValue(DefaultKeys().CertName, []byte("second")))
+
+		i, err = access.Get(log)
+		Expect(err).To(Succeed())
+		Expect(i.Cert()).To(Equal([]byte("second")))
+	})
+
+	It("reports a conflict for a secret modified since it has been read", func() {
+		server.store("cert", map[string][]byte{DefaultKeys().CertName: []byte("initial")})
+		i, err := access.Get(log)
+		Expect(err).To(Succeed())
+		Expect(i.Cert()).To(Equal([]byte("initial")))
+
+		server.store("cert", map[string][]byte{DefaultKeys().CertName: []byte("other")})
+		Expect(access.Set(log, info("mine"))).To(MatchError(certmgmt.ErrConflict))
+		Expect(server.get("cert").Data).To(HaveKeyWithValue(DefaultKeys().CertName, []byte("other")))
+
+		i, err = access.Get(log)
+		Expect(err).To(Succeed())
+		Expect(i.Cert()).To(Equal([]byte("other")))
+		Expect(access.Set(log, info("mine"))).To(Succeed())
+		Expect(server.get("cert").Data).To(HaveKeyWithValue(DefaultKeys().CertName, []byte("mine")))
+	})
+
+	It("reports a conflict for a secret created since it has been read", func() {
+		i, err := access.Get(log)
+		Expect(err).To(Succeed())
+		Expect(i).To(BeNil())
+
+		server.store("cert", map[string][]byte{DefaultKeys().CertName: []byte("other")})
+		Expect(access.Set(log, info("mine"))).To(MatchError(certmgmt.ErrConflict))
+	})
+
+	It("notifies about changes of the secret", func() {
+		notified := make(chan struct{}, 10)
+		Expect(access.(certmgmt.WatchableCertificateAccess).Watch(log, func() { notified <- struct{}{} })).To(Succeed())
+
+		server.store("cert", map[string][]byte{DefaultKeys().CertName: []byte("initial")})
+		Eventually(notified, 10*time.Second).Should(Receive())
+
+		server.store("cert", map[string][]byte{DefaultKeys().CertName: []byte("changed")})
+		Eventually(notified, 10*time.Second).Should(Receive())
+	})
+})
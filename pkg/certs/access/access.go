@@ -9,36 +9,58 @@ package access
 import (
 	"context"
 	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/gardener/controller-manager-library/pkg/certmgmt"
 	"github.com/gardener/controller-manager-library/pkg/certs"
 	"github.com/gardener/controller-manager-library/pkg/logger"
 )
 
+// maxConflicts is the number of concurrent modifications of the stored
+// certificate tolerated by a single reconciliation.
+const maxConflicts = 5
+
 type AccessSource struct {
 	base certs.WatchableSource
 
+	// lock serializes the reconciliations of the certificate
+	lock    sync.Mutex
+	trigger chan struct{}
+
 	currentCert *tls.Certificate
 	info        certmgmt.CertificateInfo
 	config      *certmgmt.Config
 	access      certmgmt.CertificateAccess
 	logger      logger.LogContext
+	status      *certs.StatusTracker
 }
 
 var _ certs.CertificateSource = &AccessSource{}
+var _ certs.StatusSource = &AccessSource{}
 
 func New(ctx context.Context, logger logger.LogContext, access certmgmt.CertificateAccess, cfg *certmgmt.Config) (*AccessSource, error) {
 	this := &AccessSource{
-		config: cfg,
-		access: access,
-		logger: logger,
+		config:  cfg,
+		access:  access,
+		logger:  logger,
+		trigger: make(chan struct{}, 1),
+		status:  certs.NewStatusTracker(fmt.Sprintf("%s", access)),
 	}
 	// Initial read of certificate and key.
 	if err := this.ReadCertificate(); err != nil {
 		return nil, err
 	}
 
+	if w, ok := access.(certmgmt.WatchableCertificateAccess); ok {
+		if err := w.Watch(logger, this.Trigger); err != nil {
+			logger.Warnf("cannot watch certificate %s: %s (polling only)", access, err)
+		}
+	}
 	this.start(ctx.Done())
 	return this, nil
 }
@@ -47,26 +69,64 @@ func (this *AccessSource) RegisterConsumer(h certs.CertificateConsumerUpdater) {
 	this.base.RegisterConsumer(h)
 }
 
+// Trigger requests a reconciliation of the certificate, for example
+// after the stored certificate has been changed.
+func (this *AccessSource) Trigger() {
+	select {
+	case this.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// ReadCertificate reads the stored certificate and updates it if required.
+// If the stored certificate is changed concurrently (for example by another
+// replica) it is read again, so that all users converge on the stored one.
 func (this *AccessSource) ReadCertificate() error {
-	info, err := this.access.Get(this.logger)
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	err := this.readCertificate()
 	if err != nil {
+		this.status.Failed(err)
+		if this.status.GetCertificateStatus().Failures == 1 {
+			this.eventf(corev1.EventTypeWarning, "CertificateUpdateFailed", "cannot update certificate: %s", err)
+		}
 		return err
 	}
-	new, err := certmgmt.UpdateCertificate(info, this.config)
-	if err != nil {
-		return err
+	this.status.Succeeded()
+	return nil
+}
+
+func (this *AccessSource) readCertificate() error {
+	var new certmgmt.CertificateInfo
+	written := false
+	for conflicts := 0; ; conflicts++ {
+		info, err := this.access.Get(this.logger)
+		if err != nil {
+			return err
+		}
+		new, err = certmgmt.UpdateCertificate(this.logger, info, this.config)
+		if err != nil {
+			return err
+		}
+		if certmgmt.Equal(info, new) {
+			break
+		}
+		err = this.access.Set(this.logger, new)
+		if err == nil {
+			written = true
+			break
+		}
+		if !errors.Is(err, certmgmt.ErrConflict) || conflicts >= maxConflicts {
+			return err
+		}
+		this.logger.Infof("certificate %s modified concurrently -> read again", this.access)
 	}
 	if this.currentCert != nil {
 		if certmgmt.Equal(this.info, new) {
 			return nil
 		}
 	}
-	if !certmgmt.Equal(this.info, new) {
-		err = this.access.Set(this.logger, new)
-		if err != nil {
-			return err
-		}
-	}
 	this.info = new
 
 	cert, err := tls.X509KeyPair(new.Cert(), new.Key())
@@ -77,10 +137,25 @@ func (this *AccessSource) ReadCertificate() error {
 	this.base.Lock()
 	this.currentCert = &cert
 	this.base.Unlock()
+	this.status.Renewed(new)
+	if written {
+		this.eventf(corev1.EventTypeNormal, "CertificateUpdated", "certificate updated (valid until %s)",
+			this.status.GetCertificateStatus().NotAfter.Format(time.RFC3339))
+	}
 	this.base.NotifyUpdate(new)
 	return nil
 }
 
+func (this *AccessSource) eventf(eventtype, reason, msgfmt string, args ...interface{}) {
+	if r, ok := this.access.(certmgmt.CertificateEventRecorder); ok {
+		r.Eventf(eventtype, reason, msgfmt, args...)
+	}
+}
+
+func (this *AccessSource) GetCertificateStatus() certs.CertificateStatus {
+	return this.status.GetCertificateStatus()
+}
+
 // GetCertificate fetches the currently loaded certificate, which may be nil.
 func (this *AccessSource) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
 	this.base.Lock()
@@ -111,11 +186,16 @@ func (this *AccessSource) watch(stop <-chan struct{}) {
 		case <-stop:
 			timer.Stop()
 			return
+		case <-this.trigger:
+			this.logger.Infof("certificate %s changed", this.access)
+			if err := this.ReadCertificate(); err != nil {
+				this.logger.Errorf("cannot reconcile certificate %s: %s", this.access, err)
+			}
 		case _, ok := <-timer.C:
 			if !ok {
 				return
 			}
-			this.logger.Errorf("reconciling certificate %s", this.access)
+			this.logger.Infof("reconciling certificate %s", this.access)
 			next := d
 
 			err := this.ReadCertificate()
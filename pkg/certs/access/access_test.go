@@ -0,0 +1,203 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package access
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/certs"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// fakeAccess keeps the certificate info in memory. Like a secret it
+// rejects writes if the info has been changed since it has been read.
+type fakeAccess struct {
+	lock    sync.Mutex
+	stored  certmgmt.CertificateInfo
+	version int
+	read    int
+	gets    int
+	sets    int
+	// concurrent is called on Set to simulate concurrent writers
+	concurrent func() certmgmt.CertificateInfo
+	notify     func()
+}
+
+var _ certmgmt.WatchableCertificateAccess = &fakeAccess{}
+
+func (this *fakeAccess) String() string {
+	return "fake"
+}
+
+func (this *fakeAccess) Get(_ logger.LogContext) (certmgmt.CertificateInfo, error) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.gets++
+	this.read = this.version
+	return this.stored, nil
+}
+
+func (this *fakeAccess) Set(_ logger.LogContext, info certmgmt.CertificateInfo) error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.sets++
+	if this.concurrent != nil {
+		if other := this.concurrent(); other != nil {
+			this.stored = other
+			this.version++
+		}
+	}
+	if this.read != this.version {
+		return certmgmt.ErrConflict
+	}
+	this.stored = info
+	this.version++
+	return nil
+}
+
+func (this *fakeAccess) Watch(_ logger.LogContext, notify func()) error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.notify = notify
+	return nil
+}
+
+// store changes the stored info like another writer and notifies the watch.
+func (this *fakeAccess) store(info certmgmt.CertificateInfo) {
+	this.lock.Lock()
+	this.stored = info
+	this.version++
+	notify := this.notify
+	this.lock.Unlock()
+	if notify != nil {
+		notify()
+	}
+}
+
+func (this *fakeAccess) counts() (int, int) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return this.gets, this.sets
+}
+
+var _ = Describe("certificate access source", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		log    logger.LogContext
+		cfg    *certmgmt.Config
+		access *fakeAccess
+	)
+
+	newInfo := func() certmgmt.CertificateInfo {
+		info, err := certmgmt.UpdateCertificate(log, nil, cfg)
+		Expect(err).To(Succeed())
+		return info
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		log = logger.New()
+		cfg = &certmgmt.Config{
+			CommonName:   "test",
+			Hosts:        certmgmt.NewDNSName("test.example.com"),
+			Validity:     10 * 24 * time.Hour,
+			Rest:         24 * time.Hour,
+			KeyAlgorithm: certmgmt.KeyAlgorithmECDSA,
+		}
+		access = &fakeAccess{}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("creates and stores a missing certificate", func() {
+		source, err := New(ctx, log, access, cfg)
+		Expect(err).To(Succeed())
+		Expect(certmgmt.Equal(source.GetCertificateInfo(), access.stored)).To(BeTrue())
+		Expect(source.GetCertificateStatus().Failures).To(Equal(0))
+	})
+
+	It("reads the certificate again after a conflict and uses the stored one", func() {
+		var other certmgmt.CertificateInfo
+		conflicts := 0
+		access.concurrent = func() certmgmt.CertificateInfo {
+			if conflicts > 0 {
+				return nil
+			}
+			conflicts++
+			other = newInfo()
+			return other
+		}
+
+		source, err := New(ctx, log, access, cfg)
+		Expect(err).To(Succeed())
+		gets, sets := access.counts()
+		Expect(gets).To(Equal(2))
+		Expect(sets).To(Equal(1))
+		Expect(certmgmt.Equal(source.GetCertificateInfo(), other)).To(BeTrue())
+	})
+
+	It("retries writes after conflicts", func() {
+		conflicts := 0
+		access.concurrent = func() certmgmt.CertificateInfo {
+			if conflicts == 2 {
+				return nil
+			}
+			conflicts++
+			// an invalid certificate forcing another renewal
+			return certmgmt.NewCertInfo(nil, nil, nil, nil)
+		}
+
+		source, err := New(ctx, log, access, cfg)
+		Expect(err).To(Succeed())
+		gets, sets := access.counts()
+		Expect(gets).To(Equal(3))
+		Expect(sets).To(Equal(3))
+		Expect(certmgmt.Equal(source.GetCertificateInfo(), access.stored)).To(BeTrue())
+	})
+
+	It("gives up after too many conflicts", func() {
+		access.concurrent = func() certmgmt.CertificateInfo {
+			return certmgmt.NewCertInfo(nil, nil, nil, nil)
+		}
+
+		_, err := New(ctx, log, access, cfg)
+		Expect(err).To(MatchError(certmgmt.ErrConflict))
+		gets, sets := access.counts()
+		Expect(gets).To(Equal(maxConflicts + 1))
+		Expect(sets).To(Equal(maxConflicts + 1))
+	})
+
+	It("reloads the certificate when the watch reports a change", func() {
+		source, err := New(ctx, log, access, cfg)
+		Expect(err).To(Succeed())
+
+		updated := make(chan certmgmt.CertificateInfo, 10)
+		source.RegisterConsumer(certs.CertificateUpdaterFunc(func(info certmgmt.CertificateInfo) {
+			updated <- info
+		}))
+
+		other := newInfo()
+		access.store(other)
+
+		var info certmgmt.CertificateInfo
+		Eventually(updated, 5*time.Second).Should(Receive(&info))
+		Expect(certmgmt.Equal(info, other)).To(BeTrue())
+		Expect(certmgmt.Equal(source.GetCertificateInfo(), other)).To(BeTrue())
+		_, sets := access.counts()
+		Expect(sets).To(Equal(1))
+	})
+})
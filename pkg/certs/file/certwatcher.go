@@ -34,9 +34,12 @@ type CertWatcher struct {
 	keyPath    string
 	cacertPath string
 	cakeyPath  string
+
+	status *certs.StatusTracker
 }
 
 var _ certs.CertificateSource = &CertWatcher{}
+var _ certs.StatusSource = &CertWatcher{}
 
 // New returns a new CertWatcher watching the given certificate and key.
 func New(ctx context.Context, logger logger.LogContext, certPath, keyPath, cacertPath, cakeyPath string) (*CertWatcher, error) {
@@ -48,6 +51,7 @@ func New(ctx context.Context, logger logger.LogContext, certPath, keyPath, cacer
 		keyPath:    keyPath,
 		cacertPath: cacertPath,
 		cakeyPath:  cakeyPath,
+		status:     certs.NewStatusTracker("file:" + certPath),
 	}
 
 	// Initial read of certificate and key.
@@ -132,6 +136,16 @@ func (this *CertWatcher) watch() {
 // and updates the current certificate on the watcher.  If a callback is set, it
 // is invoked with the new certificate.
 func (this *CertWatcher) ReadCertificate() error {
+	err := this.readCertificate()
+	if err != nil {
+		this.status.Failed(err)
+		return err
+	}
+	this.status.Succeeded()
+	return nil
+}
+
+func (this *CertWatcher) readCertificate() error {
 	info, err := certmgmt.LoadCertInfo(this.certPath, this.keyPath, this.cacertPath, this.cakeyPath)
 	if err != nil {
 		return err
@@ -146,6 +160,7 @@ func (this *CertWatcher) ReadCertificate() error {
 		this.currentCert = &cert
 		this.info = info
 		this.base.Unlock()
+		this.status.Renewed(info)
 		this.base.NotifyUpdate(this.info)
 	}
 	this.logger.Info("Updated current TLS certificate")
@@ -153,6 +168,10 @@ func (this *CertWatcher) ReadCertificate() error {
 	return nil
 }
 
+func (this *CertWatcher) GetCertificateStatus() certs.CertificateStatus {
+	return this.status.GetCertificateStatus()
+}
+
 func (this *CertWatcher) handleEvent(event fsnotify.Event) {
 	// Only care about events which may modify the contents of the file.
 	if !(isWrite(event) || isRemove(event) || isCreate(event)) {
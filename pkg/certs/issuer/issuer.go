@@ -0,0 +1,248 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package issuer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	certsecret "github.com/gardener/controller-manager-library/pkg/certmgmt/secret"
+	"github.com/gardener/controller-manager-library/pkg/certs"
+	"github.com/gardener/controller-manager-library/pkg/certs/access"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// CertificatesResource is the resource of cert-manager certificates.
+var CertificatesResource = schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+
+const (
+	// DefaultIssuerKind is the kind of the issuer used if no kind is given.
+	DefaultIssuerKind = "Issuer"
+	// DefaultIssuerGroup is the API group of the issuer used if no group is given.
+	DefaultIssuerGroup = "cert-manager.io"
+)
+
+// Spec describes a certificate requested from a cert-manager issuer.
+// The certificate object and the secret containing the issued
+// certificate are named after the secret.
+type Spec struct {
+	Secret      resources.ObjectName
+	CommonName  string
+	Hosts       certmgmt.CertificateHosts
+	IssuerName  string
+	IssuerKind  string
+	IssuerGroup string
+	// CABundle is used as CA bundle, if the issuer does not provide
+	// the CA certificate in the secret.
+	CABundle []byte
+	// Timeout is the time to wait for the initial issuing of the certificate.
+	Timeout time.Duration
+}
+
+// IssuerSource is a certificate source using a certificate issued by a
+// cert-manager issuer. The certificate is renewed by cert-manager, the
+// updated secret is taken over immediately. Certificates issued by
+// Kubernetes CertificateSigningRequests are not supported.
+type IssuerSource struct {
+	*access.AccessSource
+}
+
+var _ certs.CertificateSource = &IssuerSource{}
+
+// New creates or updates the cert-manager certificate object and waits for
+// the certificate to be issued.
+func New(ctx context.Context, logger logger.LogContext, cluster cluster.Interface, spec *Spec) (*IssuerSource, error) {
+	restcfg := cluster.Config()
+	client, err := dynamic.NewForConfig(&restcfg)
+	if err != nil {
+		return nil, err
+	}
+	certificates := client.Resource(CertificatesResource).Namespace(spec.Secret.Namespace())
+	if err := ensureCertificate(ctx, logger, certificates, spec); err != nil {
+		return nil, err
+	}
+
+	secret := certsecret.NewSecret(cluster, spec.Secret, certsecret.TLSKeys())
+	if len(spec.CABundle) > 0 {
+		secret = &caAccess{secret, spec.CABundle}
+	}
+	if err := waitForCertificate(ctx, logger, secret, spec); err != nil {
+		return nil, err
+	}
+	src, err := access.New(ctx, logger, secret, &certmgmt.Config{
+		ExternallyManaged: true,
+		Rest:              10 * time.Minute,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &IssuerSource{src}, nil
+}
+
+func ensureCertificate(ctx context.Context, logger logger.LogContext, certificates dynamic.ResourceInterface, spec *Spec) error {
+	kind := spec.IssuerKind
+	if kind == "" {
+		kind = DefaultIssuerKind
+	}
+	group := spec.IssuerGroup
+	if group == "" {
+		group = DefaultIssuerGroup
+	}
+	desired := map[string]interface{}{
+		"secretName": spec.Secret.Name(),
+		"commonName": spec.CommonName,
+		"issuerRef": map[string]interface{}{
+			"name":  spec.IssuerName,
+			"kind":  kind,
+			"group": group,
+		},
+	}
+	if names := spec.Hosts.GetDNSNames(); len(names) > 0 {
+		desired["dnsNames"] = stringList(names)
+	}
+	if ips := spec.Hosts.GetIPs(); len(ips) > 0 {
+		var list []string
+		for _, ip := range ips {
+			list = append(list, ip.String())
+		}
+		desired["ipAddresses"] = stringList(list)
+	}
+
+	obj, err := certificates.Get(ctx, spec.Secret.Name(), metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("cannot get certificate %s: %s", spec.Secret, err)
+		}
+		obj = &unstructured.Unstructured{}
+		obj.SetAPIVersion(CertificatesResource.GroupVersion().String())
+		obj.SetKind("Certificate")
+		obj.SetName(spec.Secret.Name())
+		obj.SetNamespace(spec.Secret.Namespace())
+		obj.Object["spec"] = desired
+		logger.Infof("creating certificate %s for issuer %s %q", spec.Secret, kind, spec.IssuerName)
+		if _, err := certificates.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("cannot create certificate %s: %s", spec.Secret, err)
+		}
+		return nil
+	}
+	// keep fields not maintained here (for example defaulted by cert-manager)
+	actual, _ := obj.Object["spec"].(map[string]interface{})
+	merged := map[string]interface{}{}
+	for k, v := range actual {
+		merged[k] = v
+	}
+	delete(merged, "dnsNames")
+	delete(merged, "ipAddresses")
+	for k, v := range desired {
+		merged[k] = v
+	}
+	if reflect.DeepEqual(actual, merged) {
+		return nil
+	}
+	obj.Object["spec"] = merged
+	logger.Infof("updating certificate %s for issuer %s %q", spec.Secret, kind, spec.IssuerName)
+	if _, err := certificates.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("cannot update certificate %s: %s", spec.Secret, err)
+	}
+	return nil
+}
+
+func stringList(list []string) []interface{} {
+	result := make([]interface{}, len(list))
+	for i, s := range list {
+		result[i] = s
+	}
+	return result
+}
+
+// ErrNoCACert is returned, if the issued certificate comes without CA
+// certificate and no CA bundle is configured. This is the case for many
+// issuers, for example ACME issuers.
+var ErrNoCACert = errors.New("issued certificate contains no CA certificate (ca.crt) and no CA bundle is configured")
+
+// waitForCertificate waits until the secret contains a valid
+// certificate for the requested names. It fails immediately, if the
+// certificate is issued without CA certificate, because it can never
+// become valid.
+func waitForCertificate(ctx context.Context, logger logger.LogContext, secret certmgmt.CertificateAccess, spec *Spec) error {
+	names := spec.Hosts.GetDNSNames()
+	for _, ip := range spec.Hosts.GetIPs() {
+		names = append(names, ip.String())
+	}
+	timeout := time.NewTimer(spec.Timeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+	for {
+		info, err := secret.Get(logger)
+		if err != nil {
+			return err
+		}
+		if info != nil && len(info.Cert()) > 0 && len(info.CACert()) == 0 {
+			return fmt.Errorf("certificate %s: %w", spec.Secret, ErrNoCACert)
+		}
+		if info != nil && certmgmt.IsValidInfo(info, 0, names...) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			return fmt.Errorf("certificate %s not issued within %s", spec.Secret, spec.Timeout)
+		case <-ticker.C:
+			logger.Infof("waiting for certificate %s", spec.Secret)
+		}
+	}
+}
+
+// caAccess replaces the CA certificate of a certificate
+// access by a CA bundle.
+type caAccess struct {
+	certmgmt.CertificateAccess
+	cabundle []byte
+}
+
+var _ certmgmt.WatchableCertificateAccess = &caAccess{}
+var _ certmgmt.CertificateEventRecorder = &caAccess{}
+
+func (this *caAccess) String() string {
+	return fmt.Sprintf("%s", this.CertificateAccess)
+}
+
+func (this *caAccess) Watch(logger logger.LogContext, notify func()) error {
+	if w, ok := this.CertificateAccess.(certmgmt.WatchableCertificateAccess); ok {
+		return w.Watch(logger, notify)
+	}
+	return nil
+}
+
+// Eventf records an event with the wrapped access, if it supports events.
+func (this *caAccess) Eventf(eventtype, reason, msgfmt string, args ...interface{}) {
+	if r, ok := this.CertificateAccess.(certmgmt.CertificateEventRecorder); ok {
+		r.Eventf(eventtype, reason, msgfmt, args...)
+	}
+}
+
+func (this *caAccess) Get(logger logger.LogContext) (certmgmt.CertificateInfo, error) {
+	info, err := this.CertificateAccess.Get(logger)
+	if info == nil || err != nil {
+		return info, err
+	}
+	return certmgmt.NewCertInfo(info.Cert(), info.Key(), this.cabundle, nil), nil
+}
@@ -0,0 +1,211 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package issuer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// eventAccess is a certificate access recording events.
+type eventAccess struct {
+	info    certmgmt.CertificateInfo
+	events  []string
+	watched bool
+}
+
+func (this *eventAccess) Get(logger.LogContext) (certmgmt.CertificateInfo, error) {
+	return this.info, nil
+}
+
+func (this *eventAccess) Set(_ logger.LogContext, info certmgmt.CertificateInfo) error {
+	this.info = info
+	return nil
+}
+
+func (this *eventAccess) Watch(logger.LogContext, func()) error {
+	this.watched = true
+	return nil
+}
+
+func (this *eventAccess) Eventf(eventtype, reason, msgfmt string, args ...interface{}) {
+	this.events = append(this.events, fmt.Sprintf("%s %s: %s", eventtype, reason, fmt.Sprintf(msgfmt, args...)))
+}
+
+var _ = Describe("issuer", func() {
+	Context("certificate object", func() {
+		var (
+			ctx    context.Context
+			client *fake.FakeDynamicClient
+			spec   *Spec
+		)
+
+		newClient := func(objects ...runtime.Object) {
+			client = fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{CertificatesResource: "CertificateList"}, objects...)
+		}
+
+		get := func() map[string]interface{} {
+			obj, err := client.Resource(CertificatesResource).Namespace("default").Get(ctx, "tls", metav1.GetOptions{})
+			Expect(err).To(Succeed())
+			s, _, _ := unstructured.NestedMap(obj.Object, "spec")
+			return s
+		}
+
+		existing := func(spec map[string]interface{}) runtime.Object {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+			obj.SetAPIVersion(CertificatesResource.GroupVersion().String())
+			obj.SetKind("Certificate")
+			obj.SetNamespace("default")
+			obj.SetName("tls")
+			return obj
+		}
+
+		ensure := func() error {
+			return ensureCertificate(ctx, logger.New(), client.Resource(CertificatesResource).Namespace("default"), spec)
+		}
+
+		updates := func() int {
+			n := 0
+			for _, a := range client.Actions() {
+				if a.GetVerb() == "update" {
+					n++
+				}
+			}
+			return n
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			spec = &Spec{
+				Secret:     resources.NewObjectName("default", "tls"),
+				CommonName: "webhook",
+				Hosts: certmgmt.NewCompoundHosts(
+					certmgmt.NewDNSName("webhook.default.svc"),
+					certmgmt.NewIP(net.ParseIP("10.0.0.1"))),
+				IssuerName: "ca",
+			}
+		})
+
+		It("creates a missing certificate with default issuer kind and group", func() {
+			newClient()
+			Expect(ensure()).To(Succeed())
+			Expect(get()).To(Equal(map[string]interface{}{
+				"secretName":  "tls",
+				"commonName":  "webhook",
+				"dnsNames":    []interface{}{"webhook.default.svc"},
+				"ipAddresses": []interface{}{"10.0.0.1"},
+				"issuerRef": map[string]interface{}{
+					"name":  "ca",
+					"kind":  DefaultIssuerKind,
+					"group": DefaultIssuerGroup,
+				},
+			}))
+		})
+
+		It("creates a certificate for a cluster issuer of another group", func() {
+			newClient()
+			spec.IssuerKind = "ClusterIssuer"
+			spec.IssuerGroup = "example.com"
+			spec.Hosts = certmgmt.NewDNSName("webhook.default.svc")
+			Expect(ensure()).To(Succeed())
+			s := get()
+			Expect(s["issuerRef"]).To(Equal(map[string]interface{}{"name": "ca", "kind": "ClusterIssuer", "group": "example.com"}))
+			Expect(s).NotTo(HaveKey("ipAddresses"))
+		})
+
+		It("merges the desired fields into an existing certificate", func() {
+			newClient(existing(map[string]interface{}{
+				"secretName":  "tls",
+				"commonName":  "old",
+				"duration":    "2160h",
+				"dnsNames":    []interface{}{"old.default.svc"},
+				"ipAddresses": []interface{}{"10.0.0.2"},
+				"issuerRef":   map[string]interface{}{"name": "old"},
+			}))
+			spec.Hosts = certmgmt.NewDNSName("webhook.default.svc")
+			Expect(ensure()).To(Succeed())
+			Expect(updates()).To(Equal(1))
+			Expect(get()).To(Equal(map[string]interface{}{
+				"secretName": "tls",
+				"commonName": "webhook",
+				"duration":   "2160h",
+				"dnsNames":   []interface{}{"webhook.default.svc"},
+				"issuerRef": map[string]interface{}{
+					"name":  "ca",
+					"kind":  DefaultIssuerKind,
+					"group": DefaultIssuerGroup,
+				},
+			}))
+		})
+
+		It("does not update an up-to-date certificate", func() {
+			newClient()
+			Expect(ensure()).To(Succeed())
+			Expect(ensure()).To(Succeed())
+			Expect(updates()).To(Equal(0))
+		})
+	})
+
+	Context("CA bundle access", func() {
+		var (
+			base   *eventAccess
+			access *caAccess
+		)
+
+		BeforeEach(func() {
+			base = &eventAccess{info: certmgmt.NewCertInfo([]byte("cert"), []byte("key"), []byte("ca"), []byte("cakey"))}
+			access = &caAccess{base, []byte("bundle")}
+		})
+
+		It("replaces the CA certificate by the bundle", func() {
+			info, err := access.Get(logger.New())
+			Expect(err).To(Succeed())
+			Expect(info.Cert()).To(Equal([]byte("cert")))
+			Expect(info.Key()).To(Equal([]byte("key")))
+			Expect(info.CACert()).To(Equal([]byte("bundle")))
+			Expect(info.CAKey()).To(BeNil())
+		})
+
+		It("keeps a missing certificate", func() {
+			base.info = nil
+			info, err := access.Get(logger.New())
+			Expect(err).To(Succeed())
+			Expect(info).To(BeNil())
+		})
+
+		It("fails immediately for certificates issued without CA certificate", func() {
+			base.info = certmgmt.NewCertInfo([]byte("cert"), []byte("key"), nil, nil)
+			spec := &Spec{Secret: resources.NewObjectName("default", "tls"), Hosts: certmgmt.NewCompoundHosts(), Timeout: time.Hour}
+			err := waitForCertificate(context.Background(), logger.New(), base, spec)
+			Expect(err).To(MatchError(ErrNoCACert))
+			Expect(err.Error()).To(HavePrefix("certificate default/tls: "))
+		})
+
+		It("forwards events and watches", func() {
+			access.Eventf("Warning", "CertificateUpdateFailed", "cannot update certificate: %s", "failed")
+			Expect(base.events).To(Equal([]string{"Warning CertificateUpdateFailed: cannot update certificate: failed"}))
+			Expect(access.Watch(logger.New(), func() {})).To(Succeed())
+			Expect(base.watched).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,47 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package certs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricsNamespace = "controllermanager"
+	metricsSubsystem = "certificate"
+)
+
+var (
+	certificateNotAfter = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "not_after_seconds",
+		Help:      "Expiration time of the certificate in unix seconds.",
+	}, []string{"source"})
+	caNotAfter = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "ca_not_after_seconds",
+		Help:      "Expiration time of the CA certificate in unix seconds.",
+	}, []string{"source"})
+	lastRenewal = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "last_renewal_seconds",
+		Help:      "Time the actual certificate has been taken over in unix seconds.",
+	}, []string{"source"})
+	renewalFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "renewal_failures_total",
+		Help:      "Number of failed updates of the certificate.",
+	}, []string{"source"})
+)
+
+func init() {
+	prometheus.MustRegister(certificateNotAfter, caNotAfter, lastRenewal, renewalFailures)
+}
@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package certs
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// valueOf returns the value of a gauge or counter.
+func valueOf(m prometheus.Metric) float64 {
+	data := &dto.Metric{}
+	Expect(m.Write(data)).To(Succeed())
+	if data.Gauge != nil {
+		return data.Gauge.GetValue()
+	}
+	return data.Counter.GetValue()
+}
+
+var _ = Describe("metrics", func() {
+	It("publishes renewals labeled with the source", func() {
+		info := newCertificateInfo()
+		tracker := NewStatusTracker("metrics-renewal")
+		tracker.Renewed(info)
+
+		status := tracker.GetCertificateStatus()
+		Expect(valueOf(certificateNotAfter.WithLabelValues("metrics-renewal"))).To(Equal(float64(status.NotAfter.Unix())))
+		Expect(valueOf(caNotAfter.WithLabelValues("metrics-renewal"))).To(Equal(float64(status.CANotAfter.Unix())))
+		Expect(valueOf(lastRenewal.WithLabelValues("metrics-renewal"))).To(Equal(float64(status.LastRenewal.Unix())))
+		Expect(valueOf(renewalFailures.WithLabelValues("metrics-renewal"))).To(Equal(float64(0)))
+	})
+
+	It("counts failed updates", func() {
+		tracker := NewStatusTracker("metrics-failures")
+		tracker.Failed(fmt.Errorf("first"))
+		tracker.Failed(fmt.Errorf("second"))
+		tracker.Succeeded()
+		tracker.Failed(fmt.Errorf("third"))
+
+		// the counter is not reset by successful updates
+		Expect(valueOf(renewalFailures.WithLabelValues("metrics-failures"))).To(Equal(float64(3)))
+		Expect(tracker.GetCertificateStatus().Failures).To(Equal(1))
+	})
+
+	It("publishes the metrics with the default registry", func() {
+		tracker := NewStatusTracker("metrics-registry")
+		tracker.Renewed(newCertificateInfo())
+
+		families, err := prometheus.DefaultGatherer.Gather()
+		Expect(err).To(Succeed())
+		names := map[string]bool{}
+		for _, f := range families {
+			for _, m := range f.Metric {
+				for _, l := range m.Label {
+					if l.GetName() == "source" && l.GetValue() == "metrics-registry" {
+						names[f.GetName()] = true
+					}
+				}
+			}
+		}
+		Expect(names).To(HaveKey("controllermanager_certificate_not_after_seconds"))
+		Expect(names).To(HaveKey("controllermanager_certificate_ca_not_after_seconds"))
+		Expect(names).To(HaveKey("controllermanager_certificate_last_renewal_seconds"))
+	})
+})
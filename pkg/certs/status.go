@@ -0,0 +1,102 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package certs
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/cert"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+)
+
+// CertificateStatus describes the lifetime of the
+// certificate provided by a certificate source.
+type CertificateStatus struct {
+	// NotAfter is the expiration time of the certificate.
+	NotAfter time.Time
+	// CANotAfter is the expiration time of the CA certificate.
+	CANotAfter time.Time
+	// LastRenewal is the time the actual certificate has been taken over.
+	LastRenewal time.Time
+	// Failures is the number of consecutive failed updates of the certificate.
+	Failures int
+	// LastError is the error of the last failed update.
+	LastError string
+}
+
+// StatusSource is implemented by certificate sources reporting the
+// status of their certificate.
+type StatusSource interface {
+	GetCertificateStatus() CertificateStatus
+}
+
+// StatusTracker maintains the certificate status of a certificate source
+// and publishes it as metrics labeled with the name of the source.
+type StatusTracker struct {
+	lock   sync.Mutex
+	name   string
+	status CertificateStatus
+}
+
+func NewStatusTracker(name string) *StatusTracker {
+	return &StatusTracker{name: name}
+}
+
+// Renewed records a new certificate.
+func (this *StatusTracker) Renewed(info certmgmt.CertificateInfo) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.status.NotAfter = notAfter(info.Cert())
+	this.status.CANotAfter = notAfter(info.CACert())
+	this.status.LastRenewal = time.Now()
+	this.status.Failures = 0
+	this.status.LastError = ""
+	certificateNotAfter.WithLabelValues(this.name).Set(float64(this.status.NotAfter.Unix()))
+	if !this.status.CANotAfter.IsZero() {
+		caNotAfter.WithLabelValues(this.name).Set(float64(this.status.CANotAfter.Unix()))
+	}
+	lastRenewal.WithLabelValues(this.name).Set(float64(this.status.LastRenewal.Unix()))
+}
+
+// Succeeded records a successful check of the certificate.
+func (this *StatusTracker) Succeeded() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.status.Failures = 0
+	this.status.LastError = ""
+}
+
+// Failed records a failed update of the certificate.
+func (this *StatusTracker) Failed(err error) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.status.Failures++
+	this.status.LastError = err.Error()
+	renewalFailures.WithLabelValues(this.name).Inc()
+}
+
+func (this *StatusTracker) GetCertificateStatus() CertificateStatus {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return this.status
+}
+
+func notAfter(data []byte) time.Time {
+	if len(data) == 0 {
+		return time.Time{}
+	}
+	certs, err := cert.ParseCertsPEM(data)
+	if err != nil {
+		return time.Time{}
+	}
+	return certs[0].NotAfter
+}
@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package certs
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/cert"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+func newCertificateInfo() certmgmt.CertificateInfo {
+	info, err := certmgmt.UpdateCertificate(logger.New(), nil, &certmgmt.Config{
+		CommonName:   "test",
+		Hosts:        certmgmt.NewDNSName("test.example.com"),
+		Validity:     10 * 24 * time.Hour,
+		Rest:         24 * time.Hour,
+		KeyAlgorithm: certmgmt.KeyAlgorithmECDSA,
+	})
+	Expect(err).To(Succeed())
+	return info
+}
+
+func notAfterOf(data []byte) time.Time {
+	certs, err := cert.ParseCertsPEM(data)
+	Expect(err).To(Succeed())
+	return certs[0].NotAfter
+}
+
+var _ = Describe("status tracker", func() {
+	var (
+		tracker *StatusTracker
+		info    certmgmt.CertificateInfo
+	)
+
+	BeforeEach(func() {
+		tracker = NewStatusTracker("status-test")
+		info = newCertificateInfo()
+	})
+
+	It("starts without certificate", func() {
+		Expect(tracker.GetCertificateStatus()).To(Equal(CertificateStatus{}))
+	})
+
+	It("records a renewal", func() {
+		before := time.Now()
+		tracker.Renewed(info)
+
+		status := tracker.GetCertificateStatus()
+		Expect(status.NotAfter).To(Equal(notAfterOf(info.Cert())))
+		Expect(status.CANotAfter).To(Equal(notAfterOf(info.CACert())))
+		Expect(status.LastRenewal).To(BeTemporally(">=", before))
+		Expect(status.Failures).To(Equal(0))
+		Expect(status.LastError).To(BeEmpty())
+	})
+
+	It("counts consecutive failures", func() {
+		tracker.Renewed(info)
+		renewal := tracker.GetCertificateStatus().LastRenewal
+
+		tracker.Failed(fmt.Errorf("first"))
+		tracker.Failed(fmt.Errorf("second"))
+
+		status := tracker.GetCertificateStatus()
+		Expect(status.Failures).To(Equal(2))
+		Expect(status.LastError).To(Equal("second"))
+		Expect(status.NotAfter).To(Equal(notAfterOf(info.Cert())))
+		Expect(status.LastRenewal).To(Equal(renewal))
+	})
+
+	It("resets the failures on a successful check", func() {
+		tracker.Renewed(info)
+		tracker.Failed(fmt.Errorf("failed"))
+		tracker.Succeeded()
+
+		status := tracker.GetCertificateStatus()
+		Expect(status.Failures).To(Equal(0))
+		Expect(status.LastError).To(BeEmpty())
+		Expect(status.NotAfter).To(Equal(notAfterOf(info.Cert())))
+	})
+
+	It("resets the failures on a renewal", func() {
+		tracker.Failed(fmt.Errorf("failed"))
+		tracker.Renewed(info)
+
+		status := tracker.GetCertificateStatus()
+		Expect(status.Failures).To(Equal(0))
+		Expect(status.LastError).To(BeEmpty())
+	})
+
+	It("handles certificates without CA", func() {
+		tracker.Renewed(certmgmt.NewCertInfo(info.Cert(), info.Key(), nil, nil))
+
+		status := tracker.GetCertificateStatus()
+		Expect(status.NotAfter).To(Equal(notAfterOf(info.Cert())))
+		Expect(status.CANotAfter.IsZero()).To(BeTrue())
+	})
+
+	It("handles invalid certificates", func() {
+		tracker.Renewed(certmgmt.NewCertInfo([]byte("invalid"), nil, nil, nil))
+
+		status := tracker.GetCertificateStatus()
+		Expect(status.NotAfter.IsZero()).To(BeTrue())
+		Expect(status.LastRenewal.IsZero()).To(BeFalse())
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/spf13/pflag"
 	"sigs.k8s.io/yaml"
@@ -112,6 +113,11 @@ func mapDataToArguments(name string, flags *pflag.FlagSet, data interface{}) ([]
 		}
 		return args, nil
 	case map[string]interface{}:
+		if flags != nil {
+			if f := flags.Lookup(name); f != nil && f.Value.Type() == "stringMap" {
+				return mapEntriesToArguments(name, a)
+			}
+		}
 		return MapToArguments(name, flags, a)
 	default:
 		return nil, fmt.Errorf("invalid type %T", data)
@@ -129,6 +135,28 @@ func mapDataToArguments(name string, flags *pflag.FlagSet, data interface{}) ([]
 	return []string{dash + arg}, nil
 }
 
+// mapEntriesToArguments maps the entries of a yaml or json object
+// to arguments for a string map option.
+func mapEntriesToArguments(name string, data map[string]interface{}) ([]string, error) {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	args := make([]string, 0, len(keys))
+	for _, k := range keys {
+		switch v := data[k].(type) {
+		case map[string]interface{}, []interface{}:
+			return nil, fmt.Errorf("invalid value type %T for key %q of %q", v, k, name)
+		case nil:
+			args = append(args, fmt.Sprintf("--%s=%s=", name, k))
+		default:
+			args = append(args, fmt.Sprintf("--%s=%s=%v", name, k, v))
+		}
+	}
+	return args, nil
+}
+
 func MapToArguments(prefix string, flags *pflag.FlagSet, data map[string]interface{}) ([]string, error) {
 	var args []string
 
@@ -8,6 +8,7 @@ package config
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/spf13/cobra"
@@ -32,6 +33,8 @@ type DefaultOptionSet struct {
 	validator OptionValidator
 
 	nestedSources map[string]OptionSource
+	adding        OptionSource
+	addingKey     string
 
 	renamedFlags     map[string]*pflag.Flag
 	arbitraryOptions map[string]*ArbitraryOption
@@ -132,6 +135,8 @@ func (this *DefaultOptionSet) addOption(flag, renamed *pflag.Flag, otype OptionT
 		Default:     def,
 		Description: desc,
 		FlagSet:     this.flags,
+		source:      this.adding,
+		sourceKey:   this.addingKey,
 	}
 	this.arbitraryOptions[name] = n
 	return n.Target
@@ -157,7 +162,11 @@ func (this *DefaultOptionSet) AddRenamedOption(opt *ArbitraryOption, name, short
 		}
 		flag = &copy
 	}
-	return this.addOption(flag, renamed, opt.Type, target, name, opt.Default, flag.Usage)
+	target = this.addOption(flag, renamed, opt.Type, target, name, opt.Default, flag.Usage)
+	this.arbitraryOptions[name].Reloadable = opt.Reloadable
+	this.arbitraryOptions[name].Sensitive = opt.Sensitive
+	this.arbitraryOptions[name].Validator = opt.Validator
+	return target
 }
 
 func (this *DefaultOptionSet) AddOption(otype OptionType, target interface{}, name, short string, def interface{}, desc string) interface{} {
@@ -222,10 +231,12 @@ func (this *DefaultOptionSet) Complete() {
 
 func (this *DefaultOptionSet) complete() {
 	if !this.completed {
-		for _, nested := range this.nestedSources {
+		for key, nested := range this.nestedSources {
 			// fmt.Printf("adding nested %q <- %q\n", this.name, n)
+			this.adding, this.addingKey = nested, key
 			nested.AddOptionsToSet(this)
 		}
+		this.adding, this.addingKey = nil, ""
 		// fmt.Printf("%q completed\n", this.name)
 		this.completed = true
 	}
@@ -233,9 +244,34 @@ func (this *DefaultOptionSet) complete() {
 
 func (this *DefaultOptionSet) Evaluate() error {
 	this.evalRenamed()
+	if err := this.validateValues(); err != nil {
+		return err
+	}
 	return this.evalNested()
 }
 
+// validateValues calls the value validators of the options. It is part of
+// the evaluation to reject invalid values on startup and when reloading the
+// config file.
+func (this *DefaultOptionSet) validateValues() error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	names := make([]string, 0, len(this.arbitraryOptions))
+	for name, o := range this.arbitraryOptions {
+		if o.Validator != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		o := this.arbitraryOptions[name]
+		if err := o.Validator(o.Value()); err != nil {
+			return fmt.Errorf("invalid value for option %q: %s", name, err)
+		}
+	}
+	return nil
+}
+
 func (this *DefaultOptionSet) evalRenamed() {
 	this.lock.Lock()
 	defer this.lock.Unlock()
@@ -0,0 +1,335 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// OptionInfo describes an option of an option tree.
+type OptionInfo struct {
+	// Name is the name of the option in the described option set.
+	Name string `json:"name"`
+	// Type is the flag type of the option.
+	Type string `json:"type"`
+	// Values are the allowed values of enum options.
+	Values []string `json:"values,omitempty"`
+	// Default is the default value of the option.
+	Default interface{} `json:"default,omitempty"`
+	// Description is the description of the option.
+	Description string `json:"description"`
+	// Owner is the path of the keys of the nested option sources (separated
+	// by slashes) declaring the option. For shared options it is the path to
+	// the option source sharing them. It is empty for options declared
+	// directly in the described set.
+	Owner string `json:"owner,omitempty"`
+	// Reloadable indicates a reloadable option.
+	Reloadable bool `json:"reloadable,omitempty"`
+}
+
+// DescribeOptions describes the options of a completed option set
+// sorted by name.
+func DescribeOptions(set Options) []*OptionInfo {
+	var infos []*OptionInfo
+	set.VisitOptions(func(o *ArbitraryOption) bool {
+		decl, path := declaration(o)
+		info := &OptionInfo{
+			Name:        o.Name,
+			Type:        o.Flag().Value.Type(),
+			Default:     defaultValue(decl.Default),
+			Description: o.Flag().Usage,
+			Owner:       strings.Join(path, "/"),
+			Reloadable:  o.Reloadable,
+		}
+		if e, ok := o.Type.(enumOptionType); ok {
+			info.Values = e.Values()
+		}
+		infos = append(infos, info)
+		return true
+	})
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos
+}
+
+// declaration determines the option declaring an option and the path of
+// the nested option sources leading to it by following the option sources
+// sharing the target of the option. Options shared by several sources are
+// followed up to the source sharing them.
+func declaration(o *ArbitraryOption) (*ArbitraryOption, []string) {
+	var path []string
+	for o.source != nil && o.sharedBy <= 1 {
+		opts, ok := o.source.(Options)
+		if !ok {
+			return o, append(path, o.sourceKey)
+		}
+		var next *ArbitraryOption
+		opts.VisitOptions(func(n *ArbitraryOption) bool {
+			if n.Target == o.Target {
+				next = n
+				return false
+			}
+			return true
+		})
+		if next == nil && o.sharedBy == 1 {
+			next = opts.GetOption(o.Name)
+		}
+		if next == nil {
+			break
+		}
+		path = append(path, o.sourceKey)
+		o = next
+	}
+	return o, path
+}
+
+func defaultValue(def interface{}) interface{} {
+	switch v := def.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+	case time.Duration:
+		return configValue(v)
+	case []time.Duration:
+		if len(v) == 0 {
+			return nil
+		}
+		return configValue(v)
+	case resource.Quantity:
+		if v.IsZero() {
+			return nil
+		}
+		return configValue(v)
+	case []string:
+		if len(v) == 0 {
+			return nil
+		}
+	case []int:
+		if len(v) == 0 {
+			return nil
+		}
+	case map[string]string:
+		if len(v) == 0 {
+			return nil
+		}
+	}
+	return def
+}
+
+// EffectiveConfig returns the values of all options of an option set
+// explicitly set by any configuration source, nested like in a config file.
+// Values resolved from references are replaced by the references, values of
+// sensitive options by REDACTED.
+func EffectiveConfig(set Options) map[string]interface{} {
+	cfg := map[string]interface{}{}
+	set.VisitOptions(func(o *ArbitraryOption) bool {
+		if !o.Changed() {
+			return true
+		}
+		value := configValue(copyValue(o.Target))
+		if raw, ok := referenceOf(o); ok {
+			value = raw
+		} else if o.Sensitive {
+			value = REDACTED
+		}
+		m := cfg
+		keys := strings.Split(o.Name, ".")
+		for _, k := range keys[:len(keys)-1] {
+			nested, ok := m[k].(map[string]interface{})
+			if !ok {
+				nested = map[string]interface{}{}
+				if v, found := m[k]; found {
+					nested["_"] = v
+				}
+				m[k] = nested
+			}
+			m = nested
+		}
+		last := keys[len(keys)-1]
+		if nested, ok := m[last].(map[string]interface{}); ok {
+			nested["_"] = value
+		} else {
+			m[last] = value
+		}
+		return true
+	})
+	return cfg
+}
+
+// configValue converts option values to the representation
+// used in config files.
+func configValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Duration:
+		return v.String()
+	case []time.Duration:
+		r := make([]string, len(v))
+		for i, d := range v {
+			r[i] = d.String()
+		}
+		return r
+	case resource.Quantity:
+		return v.String()
+	}
+	return value
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// JSON schema
+
+// JSONSchema generates a JSON schema for a yaml or json config file
+// for the described options. The dot separated option names are mapped
+// to nested objects. If an option is a prefix of other options, its value
+// can be given directly or by the key _ of the object.
+func JSONSchema(infos []*OptionInfo) ([]byte, error) {
+	root := &schemaNode{children: map[string]*schemaNode{}}
+	for _, info := range infos {
+		n := root
+		for _, k := range strings.Split(info.Name, ".") {
+			c := n.children[k]
+			if c == nil {
+				c = &schemaNode{children: map[string]*schemaNode{}}
+				n.children[k] = c
+			}
+			n = c
+		}
+		n.info = info
+	}
+	schema := root.schema()
+	schema["$schema"] = "https://json-schema.org/draft/2020-12/schema"
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+type schemaNode struct {
+	info     *OptionInfo
+	children map[string]*schemaNode
+}
+
+func (this *schemaNode) schema() map[string]interface{} {
+	if len(this.children) == 0 {
+		return optionSchema(this.info)
+	}
+	props := map[string]interface{}{}
+	for k, c := range this.children {
+		props[k] = c.schema()
+	}
+	object := map[string]interface{}{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	if this.info == nil {
+		return object
+	}
+	props["_"] = optionSchema(this.info)
+	return map[string]interface{}{
+		"anyOf": []interface{}{optionSchema(this.info), object},
+	}
+}
+
+func optionSchema(info *OptionInfo) map[string]interface{} {
+	var s map[string]interface{}
+	switch info.Type {
+	case "int", "int8", "int16", "int32", "int64":
+		s = map[string]interface{}{"type": "integer"}
+	case "uint", "uint8", "uint16", "uint32", "uint64":
+		s = map[string]interface{}{"type": "integer", "minimum": 0}
+	case "float32", "float64":
+		s = map[string]interface{}{"type": "number"}
+	case "bool":
+		s = map[string]interface{}{"type": "boolean"}
+	case "quantity":
+		s = map[string]interface{}{"type": []string{"string", "number"}}
+	case "stringMap":
+		s = map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": "string"}}
+	case "intSlice":
+		s = listSchema("integer")
+	case "stringArray", "stringSlice", "durationSlice":
+		s = listSchema("string")
+	default:
+		s = map[string]interface{}{"type": "string"}
+	}
+	if len(info.Values) > 0 {
+		s["enum"] = info.Values
+	}
+	if info.Description != "" {
+		s["description"] = info.Description
+	}
+	if info.Default != nil {
+		s["default"] = info.Default
+	}
+	return s
+}
+
+// listSchema accepts a single value or a list of values,
+// because single values are accepted for list options.
+func listSchema(t string) map[string]interface{} {
+	return map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"type": t},
+			map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": t}},
+		},
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Markdown
+
+// MarkdownReference generates markdown reference documentation for the
+// described options, grouped by the owner of the options.
+func MarkdownReference(title string, infos []*OptionInfo) string {
+	groups := map[string][]*OptionInfo{}
+	for _, info := range infos {
+		groups[info.Owner] = append(groups[info.Owner], info)
+	}
+	names := make([]string, 0, len(groups))
+	for g := range groups {
+		names = append(names, g)
+	}
+	sort.Strings(names)
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# %s\n", title)
+	for _, g := range names {
+		heading := g
+		if heading == "" {
+			heading = "General options"
+		}
+		fmt.Fprintf(b, "\n## %s\n\n", heading)
+		fmt.Fprintf(b, "| Option | Type | Default | Description |\n")
+		fmt.Fprintf(b, "|--------|------|---------|-------------|\n")
+		for _, info := range groups[g] {
+			t := info.Type
+			if len(info.Values) > 0 {
+				t = strings.Join(info.Values, " \\| ")
+			}
+			def := ""
+			if info.Default != nil {
+				def = fmt.Sprintf("`%v`", info.Default)
+			}
+			desc := info.Description
+			if info.Reloadable {
+				desc += " (reloadable)"
+			}
+			fmt.Fprintf(b, "| `--%s` | %s | %s | %s |\n", info.Name, t, def, markdownEscape(desc))
+		}
+	}
+	return b.String()
+}
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "|", "\\|"), "\n", " ")
+}
@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package config_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Describe", func() {
+	var (
+		main  *config.DefaultOptionSet
+		infos map[string]*config.OptionInfo
+		list  []*config.OptionInfo
+	)
+
+	BeforeEach(func() {
+		main = config.NewDefaultOptionSet("main", "")
+		main.AddStringOption(nil, "name", "", "cm", "name of the controller manager")
+		main.AddSource("plain", &plainSource{})
+
+		area := config.NewSharedOptionSet("controllers", "")
+		for _, n := range []string{"c1", "c2"} {
+			ctrl := config.NewSharedOptionSet(n, n)
+			ctrl.AddDurationOption(nil, "period", "", time.Minute, "sync period")
+			area.AddSource(n, ctrl)
+		}
+		area.GetSource("c1").(*config.SharedOptionSet).AddEnumOption(nil, "mode", "", "fast", []string{"fast", "slow"}, "mode")
+		main.AddSource("controllers", area)
+		main.AddToFlags(pflag.NewFlagSet("test", pflag.ContinueOnError))
+
+		list = config.DescribeOptions(main)
+		infos = map[string]*config.OptionInfo{}
+		for _, i := range list {
+			infos[i.Name] = i
+		}
+	})
+
+	It("should describe options", func() {
+		Expect(list[0].Name).To(Equal("c1.mode"))
+		Expect(infos["name"]).To(Equal(&config.OptionInfo{Name: "name", Type: "string", Default: "cm", Description: "name of the controller manager"}))
+		Expect(infos["level"].Owner).To(Equal("plain"))
+		Expect(infos["level"].Reloadable).To(BeTrue())
+		Expect(infos["c1.period"].Owner).To(Equal("controllers/c1"))
+		Expect(infos["c1.period"].Default).To(Equal("1m0s"))
+		Expect(infos["period"].Owner).To(Equal("controllers"))
+		Expect(infos["period"].Default).To(BeNil())
+		Expect(infos["mode"].Owner).To(Equal("controllers/c1"))
+		Expect(infos["mode"].Values).To(Equal([]string{"fast", "slow"}))
+		Expect(infos["mode"].Default).To(Equal("fast"))
+	})
+
+	It("should generate a json schema", func() {
+		data, err := config.JSONSchema(list)
+		Expect(err).NotTo(HaveOccurred())
+		schema := map[string]interface{}{}
+		Expect(json.Unmarshal(data, &schema)).To(Succeed())
+		props := schema["properties"].(map[string]interface{})
+		Expect(props).To(HaveKey("name"))
+		c1 := props["c1"].(map[string]interface{})["properties"].(map[string]interface{})
+		Expect(c1["mode"]).To(HaveKeyWithValue("enum", []interface{}{"fast", "slow"}))
+		Expect(c1["period"]).To(HaveKeyWithValue("default", "1m0s"))
+	})
+
+	It("should report the effective config", func() {
+		main = config.NewDefaultOptionSet("main", "")
+		main.AddStringOption(nil, "name", "", "", "name")
+		main.AddDurationOption(nil, "c1", "", 0, "c1 default")
+		main.AddDurationOption(nil, "c1.period", "", 0, "sync period")
+		main.AddIntOption(nil, "size", "", 0, "size")
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		main.AddToFlags(flags)
+		Expect(flags.Parse([]string{"--name=cm", "--c1=1s", "--c1.period=1m"})).To(Succeed())
+
+		Expect(config.EffectiveConfig(main)).To(Equal(map[string]interface{}{
+			"name": "cm",
+			"c1":   map[string]interface{}{"_": "1s", "period": "1m0s"},
+		}))
+	})
+
+	It("should generate markdown", func() {
+		md := config.MarkdownReference("Options", list)
+		Expect(md).To(ContainSubstring("\n## controllers/c1\n"))
+		Expect(md).To(ContainSubstring("| `--c1.mode` | fast \\| slow | `fast` | mode (one of fast, slow) |\n"))
+		Expect(md).To(ContainSubstring("| `--level` | string |  | log level (reloadable) |\n"))
+	})
+})
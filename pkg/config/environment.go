@@ -0,0 +1,81 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// EnvironmentName derives the name of the environment variable for an
+// option. The option name is upper cased, all characters other than
+// letters and digits are replaced by an underscore, and the prefix is
+// prepended (separated by an underscore).
+func EnvironmentName(prefix, name string) string {
+	b := strings.Builder{}
+	if prefix != "" {
+		b.WriteString(strings.ToUpper(prefix))
+		b.WriteString("_")
+	}
+	for _, c := range strings.ToUpper(name) {
+		if (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	return b.String()
+}
+
+// MergeEnvironment sets the flags of a flagset from the environment
+// variables derived with EnvironmentName. Flags already set (for example
+// on the command line) are ignored if override is set to false.
+// Values for array or slice flags are split at commas.
+func MergeEnvironment(prefix string, flags *pflag.FlagSet, override bool) error {
+	var err error
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if err != nil || (flag.Changed && !override) {
+			return
+		}
+		env := EnvironmentName(prefix, flag.Name)
+		value, ok := os.LookupEnv(env)
+		if !ok {
+			return
+		}
+		if s, ok := flag.Value.(pflag.SliceValue); ok {
+			values := []string{}
+			if value != "" {
+				values = strings.Split(value, ",")
+			}
+			err = s.Replace(values)
+			if err == nil {
+				flag.Changed = true
+			}
+		} else {
+			err = flags.Set(flag.Name, value)
+		}
+		if err != nil {
+			err = fmt.Errorf("invalid value for environment variable %s: %s", env, err)
+		}
+	})
+	return err
+}
+
+// AddEnvironmentUsage adds the names of the environment
+// variables to the usage of the flags of a flagset.
+func AddEnvironmentUsage(prefix string, flags *pflag.FlagSet) {
+	flags.VisitAll(func(flag *pflag.Flag) {
+		env := EnvironmentName(prefix, flag.Name)
+		if !strings.Contains(flag.Usage, env) {
+			flag.Usage = fmt.Sprintf("%s (env %s)", flag.Usage, env)
+		}
+	})
+}
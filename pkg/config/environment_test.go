@@ -0,0 +1,68 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package config_test
+
+import (
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Environment", func() {
+	var (
+		main  *config.DefaultOptionSet
+		flags *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		main = config.NewDefaultOptionSet("main", "")
+		flags = pflag.NewFlagSet("test", pflag.ExitOnError)
+	})
+
+	It("should derive variable names", func() {
+		Expect(config.EnvironmentName("cm", "controller.dns.pool-size")).To(Equal("CM_CONTROLLER_DNS_POOL_SIZE"))
+		Expect(config.EnvironmentName("", "kubeconfig")).To(Equal("KUBECONFIG"))
+	})
+
+	It("should prefer flags over environment over file", func() {
+		var a, b, c string
+		var d []string
+		main.AddStringOption(&a, "flag", "", "", "set by flag")
+		main.AddStringOption(&b, "env.value", "", "", "set by env")
+		main.AddStringOption(&c, "file", "", "", "set by file")
+		main.AddStringArrayOption(&d, "list", "", []string{"x"}, "list")
+		main.AddToFlags(flags)
+
+		for k, v := range map[string]string{"TEST_FLAG": "e1", "TEST_ENV_VALUE": "e2", "TEST_LIST": "l1,l2"} {
+			Expect(os.Setenv(k, v)).To(Succeed())
+			DeferCleanup(os.Unsetenv, k)
+		}
+
+		Expect(flags.Parse([]string{"--flag=f1"})).To(Succeed())
+		Expect(config.MergeEnvironment("test", flags, false)).To(Succeed())
+		Expect(config.MergeConfig([]byte("flag: c1\nenv:\n  value: c2\nfile: c3\n"), flags, false)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+
+		Expect(a).To(Equal("f1"))
+		Expect(b).To(Equal("e2"))
+		Expect(c).To(Equal("c3"))
+		Expect(d).To(Equal([]string{"l1", "l2"}))
+	})
+
+	It("should list variables in usage", func() {
+		main.AddStringOption(nil, "flag", "", "", "some flag")
+		main.AddToFlags(flags)
+		config.AddEnvironmentUsage("test", flags)
+		config.AddEnvironmentUsage("test", flags)
+		Expect(flags.Lookup("flag").Usage).To(Equal("some flag (env TEST_FLAG)"))
+	})
+})
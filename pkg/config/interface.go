@@ -36,6 +36,7 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // OptionSource is the interface used to add arbitrary options to an OptionSet.
@@ -111,6 +112,12 @@ type OptionSet interface {
 	AddUintOption(target *uint, name, short string, def uint, desc string) *uint
 	AddBoolOption(target *bool, name, short string, def bool, desc string) *bool
 	AddDurationOption(target *time.Duration, name, short string, def time.Duration, desc string) *time.Duration
+	AddStringMapOption(target *map[string]string, name, short string, def map[string]string, desc string) *map[string]string
+	AddFloatOption(target *float64, name, short string, def float64, desc string) *float64
+	AddQuantityOption(target *resource.Quantity, name, short string, def resource.Quantity, desc string) *resource.Quantity
+	AddEnumOption(target *string, name, short string, def string, values []string, desc string) *string
+	AddIntSliceOption(target *[]int, name, short string, def []int, desc string) *[]int
+	AddDurationSliceOption(target *[]time.Duration, name, short string, def []time.Duration, desc string) *[]time.Duration
 
 	AddOption(otype OptionType, target interface{}, name, short string, def interface{}, desc string) interface{}
 	AddRenamedOption(opt *ArbitraryOption, name, short string, desc string) interface{}
@@ -150,6 +157,24 @@ func (p OptionSetProxy) AddBoolOption(target *bool, name, short string, def bool
 func (p OptionSetProxy) AddDurationOption(target *time.Duration, name, short string, def time.Duration, desc string) *time.Duration {
 	return p(DurationOption, target, name, short, def, desc).(*time.Duration)
 }
+func (p OptionSetProxy) AddStringMapOption(target *map[string]string, name, short string, def map[string]string, desc string) *map[string]string {
+	return p(StringMapOption, target, name, short, def, desc).(*map[string]string)
+}
+func (p OptionSetProxy) AddFloatOption(target *float64, name, short string, def float64, desc string) *float64 {
+	return p(FloatOption, target, name, short, def, desc).(*float64)
+}
+func (p OptionSetProxy) AddQuantityOption(target *resource.Quantity, name, short string, def resource.Quantity, desc string) *resource.Quantity {
+	return p(QuantityOption, target, name, short, def, desc).(*resource.Quantity)
+}
+func (p OptionSetProxy) AddEnumOption(target *string, name, short string, def string, values []string, desc string) *string {
+	return p(EnumOption(values...), target, name, short, def, desc).(*string)
+}
+func (p OptionSetProxy) AddIntSliceOption(target *[]int, name, short string, def []int, desc string) *[]int {
+	return p(IntSliceOption, target, name, short, def, desc).(*[]int)
+}
+func (p OptionSetProxy) AddDurationSliceOption(target *[]time.Duration, name, short string, def []time.Duration, desc string) *[]time.Duration {
+	return p(DurationSliceOption, target, name, short, def, desc).(*[]time.Duration)
+}
 func (p OptionSetProxy) AddOption(otype OptionType, target interface{}, name, short string, def interface{}, desc string) interface{} {
 	return p(otype, target, name, short, def, desc)
 }
@@ -7,12 +7,14 @@
 package config
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 type ArbitraryOption struct {
@@ -22,6 +24,16 @@ type ArbitraryOption struct {
 	Target      interface{}
 	Type        OptionType
 	FlagSet     *pflag.FlagSet
+	// Reloadable options may be changed at runtime by reloading the config file.
+	Reloadable bool
+	// Sensitive options have values, which must not be reported, like credentials.
+	Sensitive bool
+	// Validator validates the value of the option.
+	Validator ValueValidator
+
+	source    OptionSource
+	sourceKey string
+	sharedBy  int
 }
 
 func (this *ArbitraryOption) AddToCommand(cmd *cobra.Command) {
@@ -73,3 +85,58 @@ func (this *ArbitraryOption) DurationValue() time.Duration {
 	v, _ := this.FlagSet.GetDuration(this.Name)
 	return v
 }
+func (this *ArbitraryOption) FloatValue() float64 {
+	v, _ := this.FlagSet.GetFloat64(this.Name)
+	return v
+}
+func (this *ArbitraryOption) IntSlice() []int {
+	v, _ := this.FlagSet.GetIntSlice(this.Name)
+	return v
+}
+func (this *ArbitraryOption) DurationSlice() []time.Duration {
+	v, _ := this.FlagSet.GetDurationSlice(this.Name)
+	return v
+}
+func (this *ArbitraryOption) StringMap() map[string]string {
+	v, _ := this.Value().(map[string]string)
+	return copyStringMap(v)
+}
+func (this *ArbitraryOption) QuantityValue() resource.Quantity {
+	v, _ := this.Value().(resource.Quantity)
+	return v.DeepCopy()
+}
+
+// setDefault resets the option target to the default value.
+func (this *ArbitraryOption) setDefault() {
+	v := reflect.ValueOf(this.Target).Elem()
+	if this.Default == nil {
+		v.Set(reflect.Zero(v.Type()))
+	} else {
+		v.Set(reflect.ValueOf(copyOf(this.Default)))
+	}
+}
+
+// MarkReloadable marks options of an option set as reloadable. It must be
+// called before the options are propagated to an outer option set.
+func MarkReloadable(set Options, names ...string) {
+	for _, name := range names {
+		o := set.GetOption(name)
+		if o == nil {
+			panic(fmt.Sprintf("option %q not found", name))
+		}
+		o.Reloadable = true
+	}
+}
+
+// MarkSensitive marks options of an option set as sensitive. Their values
+// are not logged or reported. It must be called before the options are
+// propagated to an outer option set.
+func MarkSensitive(set Options, names ...string) {
+	for _, name := range names {
+		o := set.GetOption(name)
+		if o == nil {
+			panic(fmt.Sprintf("option %q not found", name))
+		}
+		o.Sensitive = true
+	}
+}
@@ -21,7 +21,7 @@ func Print(log Writer, gap string, opts Options) {
 		gap = gap + "  "
 	}
 	opts.VisitOptions(func(o *ArbitraryOption) bool {
-		log("%s%s: %t: %v (%s)", gap, o.Name, o.Changed(), o.Value(), o.Description)
+		log("%s%s: %t: %v (%s)", gap, o.Name, o.Changed(), redacted(o), o.Description)
 		return true
 	})
 	if ok {
@@ -0,0 +1,362 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/utils"
+)
+
+// reloadDelay is used to collect multiple file events
+// (for example for config map volume updates) into a single reload.
+const reloadDelay = 500 * time.Millisecond
+
+// reloadLock serializes the changes of option values
+// by reloading config files and refreshing value references.
+var reloadLock sync.Mutex
+
+// OptionChange describes the change of a reloadable option.
+type OptionChange struct {
+	Option *ArbitraryOption
+	Old    interface{}
+	New    interface{}
+}
+
+// OptionChanges maps option names to their changes.
+type OptionChanges map[string]*OptionChange
+
+// Reconfigurable must be implemented by OptionSources to be notified about
+// changes of reloadable options after the config file has been reloaded.
+// Sources offering options (implementing Options) get the changes for their
+// local option names, other sources get the changes for the options they
+// added to their option set.
+type Reconfigurable interface {
+	Reconfigure(changes OptionChanges) error
+}
+
+// reevaluator may be implemented by OptionSets, which evaluate their
+// options only once, to evaluate the settings again after a reload.
+type reevaluator interface {
+	Reevaluate() error
+}
+
+// ConfigReloader reloads the reloadable options of an option tree from a
+// config file. Options set on the command line or by the environment (set
+// when creating the reloader) always keep their value. After the new values
+// have been evaluated the Reconfigurable option sources are notified.
+type ConfigReloader struct {
+	logger logger.LogContext
+	file   string
+	root   OptionSet
+	flags  *pflag.FlagSet
+	fixed  utils.StringSet
+	last   map[string][]string
+}
+
+// NewConfigReloader creates a reloader for the flags of a root option set.
+// It must be created before the config file is merged into the flag set.
+func NewConfigReloader(logger logger.LogContext, fileName string, root OptionSet, flags *pflag.FlagSet) (*ConfigReloader, error) {
+	this := &ConfigReloader{
+		logger: logger,
+		file:   fileName,
+		root:   root,
+		flags:  flags,
+		fixed:  utils.StringSet{},
+	}
+	flags.Visit(func(flag *pflag.Flag) {
+		this.fixed.Add(flag.Name)
+	})
+	values, err := this.read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %q; %s", fileName, err)
+	}
+	this.last = values
+	return this, nil
+}
+
+// Fix marks options as fixed, they keep their value when the config file
+// is reloaded. It is used for options set by configuration sources merged
+// after the config file. It must be called before the config file is watched.
+func (this *ConfigReloader) Fix(names ...string) {
+	this.fixed.Add(names...)
+}
+
+func (this *ConfigReloader) read() (map[string][]string, error) {
+	args, err := ReadConfigFile(this.file, this.flags)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string][]string{}
+	for _, arg := range args {
+		name, value, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		flag := this.flags.Lookup(name)
+		if flag == nil {
+			flag = this.flags.ShorthandLookup(name)
+		}
+		if flag == nil {
+			return nil, fmt.Errorf("invalid argument %q", name)
+		}
+		values[flag.Name] = append(values[flag.Name], value)
+	}
+	return values, nil
+}
+
+// Reload reads the config file again and applies the values of reloadable
+// options. Changes of other options are ignored and require a restart.
+func (this *ConfigReloader) Reload() error {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	values, err := this.read()
+	if err != nil {
+		return fmt.Errorf("invalid config file %q; %s", this.file, err)
+	}
+
+	old := snapshot(this.root)
+	type state struct {
+		value   interface{}
+		changed bool
+	}
+	previous := map[*ArbitraryOption]state{}
+	this.root.VisitOptions(func(o *ArbitraryOption) bool {
+		flag := this.flags.Lookup(o.Name)
+		if flag == nil || this.fixed.Contains(o.Name) {
+			return true
+		}
+		v, ok := values[o.Name]
+		if !o.Reloadable {
+			if !reflect.DeepEqual(v, this.last[o.Name]) {
+				this.logger.Warnf("option %q changed in config file %q: restart required", o.Name, this.file)
+			}
+			return true
+		}
+		previous[o] = state{copyValue(o.Target), flag.Changed}
+		if ok {
+			if err == nil {
+				err = setFlag(flag, v)
+			}
+		} else if flag.Changed {
+			o.setDefault()
+			flag.Changed = false
+		}
+		return true
+	})
+	if err == nil {
+		err = reevaluate(this.root)
+	}
+	if err != nil {
+		for o, s := range previous {
+			reflect.ValueOf(o.Target).Elem().Set(reflect.ValueOf(s.value))
+			this.flags.Lookup(o.Name).Changed = s.changed
+		}
+		if eerr := reevaluate(this.root); eerr != nil {
+			this.logger.Errorf("cannot restore previous config: %s", eerr)
+		}
+		return fmt.Errorf("invalid config file %q; %s", this.file, err)
+	}
+	this.last = values
+	return notifyChanges(this.root, old)
+}
+
+func reevaluate(root OptionSet) error {
+	if e, ok := root.(reevaluator); ok {
+		return e.Reevaluate()
+	}
+	return root.Evaluate()
+}
+
+// notifyChanges calls the Reconfigurable option sources with
+// the changed reloadable options.
+func notifyChanges(root OptionSet, old map[interface{}]interface{}) error {
+	changes := map[OptionSource]OptionChanges{}
+	add := func(src OptionSource, c *OptionChange) {
+		if changes[src] == nil {
+			changes[src] = OptionChanges{}
+		}
+		changes[src][c.Option.Name] = c
+	}
+	visitSourceTree(root, func(src OptionSource) {
+		opts, ok := src.(Options)
+		if !ok {
+			return
+		}
+		opts.VisitOptions(func(o *ArbitraryOption) bool {
+			if !o.Reloadable {
+				return true
+			}
+			value := copyValue(o.Target)
+			if reflect.DeepEqual(old[o.Target], value) {
+				return true
+			}
+			c := &OptionChange{Option: o, Old: old[o.Target], New: value}
+			add(src, c)
+			if o.source != nil {
+				if _, ok := o.source.(Options); !ok {
+					add(o.source, c)
+				}
+			}
+			return true
+		})
+	})
+
+	var errs []string
+	visitSourceTree(root, func(src OptionSource) {
+		r, ok := src.(Reconfigurable)
+		if !ok || len(changes[src]) == 0 {
+			return
+		}
+		if err := r.Reconfigure(changes[src]); err != nil {
+			errs = append(errs, err.Error())
+		}
+	})
+	if len(errs) > 0 {
+		return fmt.Errorf("reconfiguration failed: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// Watch watches the config file and reloads it on changes until
+// the context is cancelled. The directory of the file is watched,
+// because config map volumes replace the file by switching a
+// symbolic link.
+func (this *ConfigReloader) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(this.file)); err != nil {
+		_ = watcher.Close()
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		_ = watcher.Close()
+	}()
+	go func() {
+		var pending *time.Timer
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 || !this.relevant(event.Name) {
+					continue
+				}
+				if pending != nil {
+					pending.Stop()
+				}
+				pending = time.AfterFunc(reloadDelay, func() {
+					this.logger.Infof("config file %q changed -> reloading", this.file)
+					if err := this.Reload(); err != nil {
+						this.logger.Errorf("%s", err)
+					}
+				})
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				this.logger.Errorf("config file watch error for %q: %s", this.file, err)
+			}
+		}
+	}()
+	return nil
+}
+
+func (this *ConfigReloader) relevant(name string) bool {
+	return filepath.Clean(name) == filepath.Clean(this.file) ||
+		filepath.Base(name) == "..data" && filepath.Dir(name) == filepath.Dir(this.file)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func visitSourceTree(src OptionSource, f func(OptionSource)) {
+	f(src)
+	if s, ok := src.(OptionSourceSource); ok {
+		var nested []string
+		sources := map[string]OptionSource{}
+		s.VisitSources(func(key string, n OptionSource) bool {
+			nested = append(nested, key)
+			sources[key] = n
+			return true
+		})
+		sort.Strings(nested)
+		for _, key := range nested {
+			visitSourceTree(sources[key], f)
+		}
+	}
+}
+
+// snapshot copies the actual values of all reloadable options of an option tree.
+func snapshot(root OptionSource) map[interface{}]interface{} {
+	values := map[interface{}]interface{}{}
+	visitSourceTree(root, func(src OptionSource) {
+		if opts, ok := src.(Options); ok {
+			opts.VisitOptions(func(o *ArbitraryOption) bool {
+				if o.Reloadable {
+					values[o.Target] = copyValue(o.Target)
+				}
+				return true
+			})
+		}
+	})
+	return values
+}
+
+func copyValue(target interface{}) interface{} {
+	return copyOf(reflect.ValueOf(target).Elem().Interface())
+}
+
+// copyOf copies option values sharing data (slices, maps and quantities).
+func copyOf(value interface{}) interface{} {
+	if q, ok := value.(resource.Quantity); ok {
+		return q.DeepCopy()
+	}
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Kind() == reflect.Slice && !v.IsNil():
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(c, v)
+		return c.Interface()
+	case v.Kind() == reflect.Map && !v.IsNil():
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), iter.Value())
+		}
+		return c.Interface()
+	}
+	return value
+}
+
+func setFlag(flag *pflag.Flag, values []string) error {
+	if s, ok := flag.Value.(pflag.SliceValue); ok {
+		if err := s.Replace(values); err != nil {
+			return fmt.Errorf("invalid value for %q: %s", flag.Name, err)
+		}
+	} else {
+		if err := flag.Value.Set(values[len(values)-1]); err != nil {
+			return fmt.Errorf("invalid value for %q: %s", flag.Name, err)
+		}
+	}
+	flag.Changed = true
+	return nil
+}
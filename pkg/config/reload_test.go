@@ -0,0 +1,180 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type plainSource struct {
+	level   string
+	pinned  string
+	lock    sync.Mutex
+	changes []config.OptionChanges
+}
+
+func (this *plainSource) AddOptionsToSet(set config.OptionSet) {
+	set.AddStringOption(&this.level, "level", "", "", "log level")
+	set.AddStringOption(&this.pinned, "pinned", "", "", "pinned value")
+	config.MarkReloadable(set, "level", "pinned")
+}
+
+func (this *plainSource) Reconfigure(changes config.OptionChanges) error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.changes = append(this.changes, changes)
+	return nil
+}
+
+// Changes returns the notified changes, it synchronizes with
+// option refreshes running in the background.
+func (this *plainSource) Changes() []config.OptionChanges {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return append([]config.OptionChanges(nil), this.changes...)
+}
+
+type sharedSource struct {
+	*config.SharedOptionSet
+	changes []config.OptionChanges
+}
+
+func (this *sharedSource) Reconfigure(changes config.OptionChanges) error {
+	this.changes = append(this.changes, changes)
+	return nil
+}
+
+var _ = Describe("Reload", func() {
+	var (
+		main  *config.DefaultOptionSet
+		flags *pflag.FlagSet
+		file  string
+	)
+
+	BeforeEach(func() {
+		main = config.NewDefaultOptionSet("main", "")
+		flags = pflag.NewFlagSet("test", pflag.ExitOnError)
+		file = filepath.Join(GinkgoT().TempDir(), "config.yaml")
+	})
+
+	write := func(data string) {
+		Expect(os.WriteFile(file, []byte(data), 0600)).To(Succeed())
+	}
+
+	It("should reload reloadable options", func() {
+		var name string
+		plain := &plainSource{}
+		shared := &sharedSource{SharedOptionSet: config.NewSharedOptionSet("ctrl", "ctrl")}
+		size := shared.AddIntOption(nil, "size", "", 2, "pool size")
+		config.MarkReloadable(shared, "size")
+		main.AddStringOption(&name, "name", "", "", "not reloadable")
+		main.AddSource("plain", plain)
+		main.AddSource("ctrl", shared)
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--pinned=cmd"})).To(Succeed())
+		write("level: debug\npinned: file\nsize: 3\nname: a\n")
+		reloader, err := config.NewConfigReloader(logger.New(), file, main, flags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.MergeConfigFile(file, flags, false)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(plain.level).To(Equal("debug"))
+		Expect(plain.pinned).To(Equal("cmd"))
+		Expect(*size).To(Equal(3))
+
+		write("level: warn\npinned: file2\nctrl:\n  size: 5\nname: b\n")
+		Expect(reloader.Reload()).To(Succeed())
+		Expect(plain.level).To(Equal("warn"))
+		Expect(plain.pinned).To(Equal("cmd"))
+		Expect(name).To(Equal("a"))
+		Expect(*size).To(Equal(5))
+		Expect(plain.changes).To(HaveLen(1))
+		Expect(plain.changes[0]).To(HaveLen(1))
+		Expect(plain.changes[0]["level"].Old).To(Equal("debug"))
+		Expect(plain.changes[0]["level"].New).To(Equal("warn"))
+		Expect(shared.changes).To(HaveLen(1))
+		Expect(shared.changes[0]["size"].Old).To(Equal(3))
+		Expect(shared.changes[0]["size"].New).To(Equal(5))
+
+		write("name: b\n")
+		Expect(reloader.Reload()).To(Succeed())
+		Expect(plain.level).To(Equal(""))
+		Expect(*size).To(Equal(2))
+		Expect(shared.changes).To(HaveLen(2))
+		Expect(shared.changes[1]["size"].New).To(Equal(2))
+	})
+
+	It("should keep the values of fixed options set after the config file", func() {
+		plain := &plainSource{}
+		main.AddSource("plain", plain)
+		main.AddToFlags(flags)
+
+		write("pinned: file\n")
+		reloader, err := config.NewConfigReloader(logger.New(), file, main, flags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.MergeConfigFile(file, flags, false)).To(Succeed())
+		Expect(flags.Set("level", "object")).To(Succeed())
+		reloader.Fix("level")
+		Expect(main.Evaluate()).To(Succeed())
+
+		write("pinned: file2\n")
+		Expect(reloader.Reload()).To(Succeed())
+		Expect(plain.level).To(Equal("object"))
+		Expect(plain.pinned).To(Equal("file2"))
+	})
+
+	It("should keep values for invalid config files", func() {
+		var size int
+		main.AddIntOption(&size, "size", "", 1, "pool size")
+		config.MarkReloadable(main, "size")
+		main.AddToFlags(flags)
+
+		write("size: 3\n")
+		reloader, err := config.NewConfigReloader(logger.New(), file, main, flags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.MergeConfigFile(file, flags, false)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+
+		write("size: abc\n")
+		Expect(reloader.Reload()).NotTo(Succeed())
+		Expect(size).To(Equal(3))
+	})
+
+	It("should refuse values rejected by a value validator", func() {
+		var size int
+		main.AddIntOption(&size, "size", "", 1, "pool size")
+		config.MarkReloadable(main, "size")
+		config.AddValueValidator(main, "size", func(v interface{}) error {
+			if v.(int) > 10 {
+				return fmt.Errorf("size too large")
+			}
+			return nil
+		})
+		main.AddToFlags(flags)
+
+		write("size: 3\n")
+		reloader, err := config.NewConfigReloader(logger.New(), file, main, flags)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.MergeConfigFile(file, flags, false)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+
+		write("size: 20\n")
+		Expect(reloader.Reload()).To(MatchError(ContainSubstring("size too large")))
+		Expect(size).To(Equal(3))
+	})
+})
@@ -0,0 +1,285 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// ValueResolver resolves references to option values given as URL
+// (for example file:///path or secret://namespace/name#key).
+type ValueResolver interface {
+	ResolveValue(ref *url.URL) (string, error)
+}
+
+// ValueResolverFunc is a function usable as ValueResolver.
+type ValueResolverFunc func(ref *url.URL) (string, error)
+
+func (this ValueResolverFunc) ResolveValue(ref *url.URL) (string, error) {
+	return this(ref)
+}
+
+// FileValueResolver resolves file:///path references to the content of
+// the file. Trailing line breaks are removed.
+var FileValueResolver = ValueResolverFunc(func(ref *url.URL) (string, error) {
+	if ref.Host != "" && ref.Host != "localhost" {
+		return "", fmt.Errorf("remote files not supported")
+	}
+	data, err := os.ReadFile(filepath.Clean(ref.Path))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+})
+
+type reference struct {
+	option     string
+	raw        string
+	ref        *url.URL
+	value      string
+	reloadable bool
+	// rejected is the last changed value not applied to
+	// a non-reloadable option, to report it only once.
+	rejected string
+}
+
+var references = struct {
+	lock      sync.Mutex
+	resolvers map[string]ValueResolver
+	targets   map[*string]*reference
+}{
+	resolvers: map[string]ValueResolver{"file": FileValueResolver},
+	targets:   map[*string]*reference{},
+}
+
+// RegisterValueResolver registers a resolver for option values given as
+// reference with the given URL scheme. References are resolved by
+// ResolveValues, references with a scheme registered later are kept
+// until ResolveValues is called again.
+func RegisterValueResolver(scheme string, resolver ValueResolver) {
+	references.lock.Lock()
+	defer references.lock.Unlock()
+	references.resolvers[scheme] = resolver
+}
+
+// ResolveValues replaces the values of the string options of an option tree
+// given as reference (scheme://...) with a registered scheme by the resolved
+// values. It is called when the main configuration is evaluated, after
+// the options have been set and before they are evaluated, and can be called
+// again after registering additional resolvers. In this case the option tree
+// must be evaluated again, for the option sources evaluating the options to
+// see the resolved values instead of the references.
+func ResolveValues(root OptionSource) error {
+	references.lock.Lock()
+	defer references.lock.Unlock()
+
+	var errs []string
+	visitSourceTree(root, func(src OptionSource) {
+		opts, ok := src.(Options)
+		if !ok {
+			return
+		}
+		opts.VisitOptions(func(o *ArbitraryOption) bool {
+			target, ok := o.Target.(*string)
+			if !ok {
+				return true
+			}
+			if r := references.targets[target]; r != nil {
+				if r.value == *target {
+					return true
+				}
+				delete(references.targets, target)
+			}
+			ref := parseReference(*target)
+			if ref == nil || references.resolvers[ref.Scheme] == nil {
+				return true
+			}
+			value, err := references.resolvers[ref.Scheme].ResolveValue(ref)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("option %q: cannot resolve %q: %s", o.Name, *target, err))
+				return true
+			}
+			references.targets[target] = &reference{option: o.Name, raw: *target, ref: ref, value: value, reloadable: o.Reloadable}
+			*target = value
+			return true
+		})
+	})
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func parseReference(value string) *url.URL {
+	if !strings.Contains(value, "://") {
+		return nil
+	}
+	ref, err := url.Parse(value)
+	if err != nil || ref.Scheme == "" {
+		return nil
+	}
+	return ref
+}
+
+// redacted returns the reference used to set an option value resolved by
+// ResolveValues instead of the (sensitive) value itself. Values of options
+// marked as sensitive are replaced.
+func redacted(o *ArbitraryOption) interface{} {
+	if raw, ok := referenceOf(o); ok {
+		return raw + " (resolved)"
+	}
+	if o.Sensitive {
+		return REDACTED
+	}
+	return o.Value()
+}
+
+// REDACTED replaces the values of sensitive options.
+const REDACTED = "(redacted)"
+
+// referenceOf returns the reference the actual value of an option
+// has been resolved from. Values inherited from shared options are
+// traced back to the shared option.
+func referenceOf(o *ArbitraryOption) (string, bool) {
+	target, ok := o.Target.(*string)
+	if !ok {
+		return "", false
+	}
+	inheritance.lock.Lock()
+	origin := inheritance.origins[target]
+	inheritance.lock.Unlock()
+
+	references.lock.Lock()
+	defer references.lock.Unlock()
+	if r := references.targets[target]; r != nil && r.value == *target {
+		return r.raw, true
+	}
+	if origin != nil && *origin == *target {
+		if r := references.targets[origin]; r != nil && r.value == *origin {
+			return r.raw, true
+		}
+	}
+	return "", false
+}
+
+// inheritance maps the targets of options inheriting the value of
+// a shared option to the target of the shared option.
+var inheritance = struct {
+	lock    sync.Mutex
+	origins map[*string]*string
+}{
+	origins: map[*string]*string{},
+}
+
+// setInherited records the target of the shared option a target
+// inherits its value from, or removes it for a nil origin.
+func setInherited(target, origin interface{}) {
+	t, ok := target.(*string)
+	if !ok {
+		return
+	}
+	inheritance.lock.Lock()
+	defer inheritance.lock.Unlock()
+	if o, ok := origin.(*string); ok && o != nil {
+		inheritance.origins[t] = o
+	} else {
+		delete(inheritance.origins, t)
+	}
+}
+
+// refreshValues resolves the references of reloadable options of an
+// option tree again and sets the changed values. Changes of other
+// options are logged.
+func refreshValues(logger logger.LogContext, root OptionSource) bool {
+	references.lock.Lock()
+	defer references.lock.Unlock()
+
+	changed := false
+	visitSourceTree(root, func(src OptionSource) {
+		opts, ok := src.(Options)
+		if !ok {
+			return
+		}
+		opts.VisitOptions(func(o *ArbitraryOption) bool {
+			if target, ok := o.Target.(*string); ok && refreshValue(logger, target) {
+				changed = true
+			}
+			return true
+		})
+	})
+	return changed
+}
+
+func refreshValue(logger logger.LogContext, target *string) bool {
+	r := references.targets[target]
+	if r == nil || *target != r.value {
+		return false
+	}
+	value, err := references.resolvers[r.ref.Scheme].ResolveValue(r.ref)
+	if err != nil {
+		logger.Errorf("option %q: cannot resolve %q: %s", r.option, r.raw, err)
+		return false
+	}
+	if value == r.value {
+		r.rejected = ""
+		return false
+	}
+	if !r.reloadable {
+		if value != r.rejected {
+			logger.Warnf("value of option %q (%s) changed: restart required", r.option, r.raw)
+			r.rejected = value
+		}
+		return false
+	}
+	logger.Infof("value of option %q (%s) changed", r.option, r.raw)
+	r.value = value
+	*target = value
+	return true
+}
+
+// WatchValues periodically resolves the references of the option values of
+// an option tree again until the context is cancelled. If values of
+// reloadable options are changed, the option tree is evaluated again and
+// the Reconfigurable option sources are notified like for a reload of the
+// config file.
+func WatchValues(ctx context.Context, logger logger.LogContext, root OptionSet, period time.Duration) {
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				reloadLock.Lock()
+				old := snapshot(root)
+				if refreshValues(logger, root) {
+					err := reevaluate(root)
+					if err == nil {
+						err = notifyChanges(root, old)
+					}
+					if err != nil {
+						logger.Errorf("%s", err)
+					}
+				}
+				reloadLock.Unlock()
+			}
+		}
+	}()
+}
@@ -0,0 +1,233 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package config_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type warningLogger struct {
+	logger.LogContext
+	lock     sync.Mutex
+	warnings []string
+}
+
+func (this *warningLogger) Warnf(msgfmt string, args ...interface{}) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.warnings = append(this.warnings, fmt.Sprintf(msgfmt, args...))
+}
+
+func (this *warningLogger) Warnings() []string {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return append([]string(nil), this.warnings...)
+}
+
+// derivedSource derives a value from an option when it is evaluated.
+type derivedSource struct {
+	user     string
+	greeting string
+}
+
+func (this *derivedSource) AddOptionsToSet(set config.OptionSet) {
+	set.AddStringOption(&this.user, "user", "", "", "user name")
+}
+
+func (this *derivedSource) Evaluate() error {
+	this.greeting = "hello " + this.user
+	return nil
+}
+
+var _ = Describe("Value references", func() {
+	var (
+		main  *config.DefaultOptionSet
+		flags *pflag.FlagSet
+		file  string
+	)
+
+	BeforeEach(func() {
+		main = config.NewDefaultOptionSet("main", "")
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		file = filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(file, []byte("secret-token\n"), 0600)).To(Succeed())
+	})
+
+	It("should resolve references of string options", func() {
+		shared := config.NewSharedOptionSet("c1", "c1")
+		token := shared.AddStringOption(nil, "token", "", "", "token")
+		password := shared.AddStringOption(nil, "password", "", "", "password")
+		main.AddSource("c1", shared)
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--token=file://" + file, "--password=test://user"})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(*token).To(Equal("secret-token"))
+		Expect(*password).To(Equal("test://user"))
+
+		config.RegisterValueResolver("test", config.ValueResolverFunc(func(ref *url.URL) (string, error) {
+			return "pw-" + ref.Host, nil
+		}))
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(*password).To(Equal("pw-user"))
+
+		var lines []string
+		config.Print(func(msgfmt string, args ...interface{}) {
+			lines = append(lines, fmt.Sprintf(msgfmt, args...))
+		}, "", main)
+		Expect(lines).To(ContainElement("  token: true: file://" + file + " (resolved) (token)"))
+		Expect(lines).NotTo(ContainElement(ContainSubstring("secret-token")))
+		Expect(lines).NotTo(ContainElement(ContainSubstring("pw-user")))
+	})
+
+	It("should evaluate resolved values of late registered resolvers", func() {
+		derived := &derivedSource{}
+		main.AddSource("derived", derived)
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--user=late://admin"})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(derived.greeting).To(Equal("hello late://admin"))
+
+		config.RegisterValueResolver("late", config.ValueResolverFunc(func(ref *url.URL) (string, error) {
+			return "user-" + ref.Host, nil
+		}))
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(derived.greeting).To(Equal("hello user-admin"))
+	})
+
+	It("should redact the values of sensitive options", func() {
+		shared := config.NewSharedOptionSet("c1", "c1")
+		shared.AddStringOption(nil, "password", "", "", "password")
+		config.MarkSensitive(shared, "password")
+		main.AddSource("c1", shared)
+		main.AddStringOption(nil, "user", "", "", "user")
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--password=secret", "--c1.password=other", "--user=admin"})).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(config.EffectiveConfig(main)).To(Equal(map[string]interface{}{
+			"password": config.REDACTED,
+			"c1":       map[string]interface{}{"password": config.REDACTED},
+			"user":     "admin",
+		}))
+
+		var lines []string
+		config.Print(func(msgfmt string, args ...interface{}) {
+			lines = append(lines, fmt.Sprintf(msgfmt, args...))
+		}, "", main)
+		Expect(lines).NotTo(ContainElement(ContainSubstring("secret")))
+		Expect(lines).NotTo(ContainElement(ContainSubstring("other")))
+	})
+
+	It("should report unresolvable references", func() {
+		main.AddStringOption(nil, "token", "", "", "token")
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--token=file:///does/not/exist"})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(MatchError(ContainSubstring(`option "token": cannot resolve "file:///does/not/exist"`)))
+	})
+
+	It("should refresh reloadable options", func() {
+		plain := &plainSource{}
+		main.AddSource("plain", plain)
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--level=file://" + file})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(plain.level).To(Equal("secret-token"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		config.WatchValues(ctx, logger.New(), main, 10*time.Millisecond)
+		Expect(os.WriteFile(file, []byte("new-token\n"), 0600)).To(Succeed())
+		Eventually(plain.Changes).Should(HaveLen(1))
+		cancel()
+		changes := plain.Changes()
+		Expect(changes[0]["level"].Old).To(Equal("secret-token"))
+		Expect(changes[0]["level"].New).To(Equal("new-token"))
+		Expect(plain.level).To(Equal("new-token"))
+	})
+
+	It("should redact resolved values only for the options set by reference", func() {
+		token := main.AddStringOption(nil, "token", "", "", "token")
+		main.AddStringOption(nil, "plain", "", "", "plain")
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--token=file://" + file, "--plain=secret-token"})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(*token).To(Equal("secret-token"))
+
+		var lines []string
+		config.Print(func(msgfmt string, args ...interface{}) {
+			lines = append(lines, fmt.Sprintf(msgfmt, args...))
+		}, "", main)
+		Expect(lines).To(ContainElement("  token: true: file://" + file + " (resolved) (token)"))
+		Expect(lines).To(ContainElement("  plain: true: secret-token (plain)"))
+
+		Expect(config.EffectiveConfig(main)).To(Equal(map[string]interface{}{
+			"token": "file://" + file,
+			"plain": "secret-token",
+		}))
+	})
+
+	It("should drop references of options set to plain values", func() {
+		token := main.AddStringOption(nil, "token", "", "", "token")
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--token=file://" + file})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(config.EffectiveConfig(main)).To(HaveKeyWithValue("token", "file://"+file))
+
+		Expect(flags.Set("token", "plain-token")).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(*token).To(Equal("plain-token"))
+		Expect(config.EffectiveConfig(main)).To(HaveKeyWithValue("token", "plain-token"))
+	})
+
+	It("should report changes of non-reloadable options once", func() {
+		main.AddStringOption(nil, "token", "", "", "token")
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--token=file://" + file})).To(Succeed())
+		Expect(config.ResolveValues(main)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+
+		log := &warningLogger{LogContext: logger.New()}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		config.WatchValues(ctx, log, main, 10*time.Millisecond)
+
+		Expect(os.WriteFile(file, []byte("new-token\n"), 0600)).To(Succeed())
+		Eventually(log.Warnings).Should(HaveLen(1))
+		Consistently(log.Warnings, 100*time.Millisecond, 10*time.Millisecond).Should(HaveLen(1))
+		Expect(log.Warnings()[0]).To(ContainSubstring(`value of option "token" (file://` + file + `) changed: restart required`))
+		Expect(main.GetOption("token").StringValue()).To(Equal("secret-token"))
+
+		Expect(os.WriteFile(file, []byte("other-token\n"), 0600)).To(Succeed())
+		Eventually(log.Warnings).Should(HaveLen(2))
+	})
+})
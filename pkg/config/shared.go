@@ -14,8 +14,9 @@ import (
 
 type SharedOptionSet struct {
 	*DefaultOptionSet
-	unshared map[string]bool
-	shared   OptionSet
+	unshared  map[string]bool
+	inherited map[string]bool
+	shared    OptionSet
 
 	descriptionMapper StringMapper
 }
@@ -43,6 +44,7 @@ func NewSharedOptionSet(name, prefix string, descMappers ...StringMapper) *Share
 	s := &SharedOptionSet{
 		DefaultOptionSet:  NewDefaultOptionSet(name, prefix),
 		unshared:          map[string]bool{},
+		inherited:         map[string]bool{},
 		descriptionMapper: descMapper,
 	}
 	return s
@@ -77,25 +79,54 @@ func (this *SharedOptionSet) AddOptionsToSet(set OptionSet) {
 			} else {
 				set.AddOption(o.Type, nil, o.Name, o.Flag().Shorthand, nil, o.Description)
 			}
+			shared := set.GetOption(name)
+			shared.sharedBy++
+			if o.Reloadable {
+				shared.Reloadable = true
+			}
+			if o.Sensitive {
+				shared.Sensitive = true
+			}
+			shared.Validator = chainValidators(shared.Validator, o.Validator)
 		}
 	}
 }
 
+// evalShared propagates the values of changed shared options to the
+// local options not explicitly set. Because it is called again when the
+// config is reloaded, it remembers inherited values to reset them if the
+// shared option is not set anymore.
 func (this *SharedOptionSet) evalShared() {
 	this.lock.Lock()
 	defer this.lock.Unlock()
 
 	// fmt.Printf("eval shared %s\n", this.prefix)
 	for name, o := range this.arbitraryOptions {
-		if !this.unshared[name] && !o.Changed() {
-			// fmt.Printf("eval shared %s\n", name)
-			shared := this.shared.GetOption(name)
-			if shared.Changed() {
-				value := reflect.ValueOf(shared.Target).Elem()
-				// fmt.Printf("   %s changed shared\n", name)
-				o.Flag().Changed = true
-				reflect.ValueOf(o.Target).Elem().Set(value)
+		if this.unshared[name] {
+			continue
+		}
+		inherited := this.inherited[name]
+		if o.Changed() && !(inherited && this.prefix == "") {
+			if inherited {
+				setInherited(o.Target, nil)
 			}
+			delete(this.inherited, name)
+			continue
+		}
+		// fmt.Printf("eval shared %s\n", name)
+		shared := this.shared.GetOption(name)
+		if shared.Changed() {
+			value := reflect.ValueOf(shared.Target).Elem()
+			// fmt.Printf("   %s changed shared\n", name)
+			o.Flag().Changed = true
+			reflect.ValueOf(o.Target).Elem().Set(value)
+			setInherited(o.Target, shared.Target)
+			this.inherited[name] = true
+		} else if inherited {
+			o.Flag().Changed = false
+			o.setDefault()
+			setInherited(o.Target, nil)
+			delete(this.inherited, name)
 		}
 	}
 }
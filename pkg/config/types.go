@@ -7,9 +7,12 @@
 package config
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/gardener/controller-manager-library/pkg/utils"
 )
@@ -37,8 +40,48 @@ var (
 	UintOption        = optionTypeImpl(tUintOption)
 	BoolOption        = optionTypeImpl(tBoolOption)
 	DurationOption    = optionTypeImpl(tDurationOption)
+
+	StringMapOption     = optionTypeImpl(tStringMapOption)
+	FloatOption         = optionTypeImpl(tFloatOption)
+	QuantityOption      = optionTypeImpl(tQuantityOption)
+	IntSliceOption      = optionTypeImpl(tIntSliceOption)
+	DurationSliceOption = optionTypeImpl(tDurationSliceOption)
 )
 
+// EnumOption is a string option restricted to the given values.
+// Enum option types with the same values are equal.
+func EnumOption(values ...string) OptionType {
+	return enumOptionType{strings.Join(values, enumValueSeparator)}
+}
+
+// enumValueSeparator separates the values of an enum option type. The values
+// are kept in a single string to keep the type comparable, so the separator
+// must not be a character used in option values.
+const enumValueSeparator = "\x00"
+
+type enumOptionType struct {
+	values string
+}
+
+func (t enumOptionType) Values() []string {
+	return strings.Split(t.values, enumValueSeparator)
+}
+
+func (t enumOptionType) AddToFlags(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
+	if def == nil {
+		def = ""
+	}
+	if utils.IsNil(target) {
+		target = new(string)
+	}
+	suffix := fmt.Sprintf(" (one of %s)", strings.Join(t.Values(), ", "))
+	if !strings.HasSuffix(desc, suffix) {
+		desc += suffix
+	}
+	flags.VarP(newEnumValue(def.(string), target.(*string), t.Values()), name, short, desc)
+	return target
+}
+
 func tStringOption(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
 	if def == nil {
 		def = ""
@@ -104,3 +147,58 @@ func tDurationOption(flags *pflag.FlagSet, target interface{}, name, short strin
 	}
 	return flags.DurationP(name, short, def.(time.Duration), desc)
 }
+
+func tStringMapOption(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
+	if def == nil {
+		def = map[string]string(nil)
+	}
+	if utils.IsNil(target) {
+		target = new(map[string]string)
+	}
+	flags.VarP(newStringMapValue(def.(map[string]string), target.(*map[string]string)), name, short, desc)
+	return target
+}
+
+func tFloatOption(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
+	if def == nil {
+		def = float64(0)
+	}
+	if !utils.IsNil(target) {
+		flags.Float64VarP(target.(*float64), name, short, def.(float64), desc)
+		return target
+	}
+	return flags.Float64P(name, short, def.(float64), desc)
+}
+
+func tQuantityOption(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
+	if def == nil {
+		def = resource.Quantity{}
+	}
+	if utils.IsNil(target) {
+		target = new(resource.Quantity)
+	}
+	flags.VarP(newQuantityValue(def.(resource.Quantity), target.(*resource.Quantity)), name, short, desc)
+	return target
+}
+
+func tIntSliceOption(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
+	if def == nil {
+		def = []int(nil)
+	}
+	if !utils.IsNil(target) {
+		flags.IntSliceVarP(target.(*[]int), name, short, def.([]int), desc)
+		return target
+	}
+	return flags.IntSliceP(name, short, def.([]int), desc)
+}
+
+func tDurationSliceOption(flags *pflag.FlagSet, target interface{}, name, short string, def interface{}, desc string) interface{} {
+	if def == nil {
+		def = []time.Duration(nil)
+	}
+	if !utils.IsNil(target) {
+		flags.DurationSliceVarP(target.(*[]time.Duration), name, short, def.([]time.Duration), desc)
+		return target
+	}
+	return flags.DurationSliceP(name, short, def.([]time.Duration), desc)
+}
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package config_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Option types", func() {
+	var (
+		main  *config.DefaultOptionSet
+		flags *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		main = config.NewDefaultOptionSet("main", "")
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+	})
+
+	It("should handle typed options on the command line", func() {
+		labels := main.AddStringMapOption(nil, "labels", "", map[string]string{"a": "b"}, "labels")
+		ratio := main.AddFloatOption(nil, "ratio", "", 0.5, "ratio")
+		limit := main.AddQuantityOption(nil, "limit", "", resource.MustParse("1Gi"), "limit")
+		mode := main.AddEnumOption(nil, "mode", "", "fast", []string{"fast", "slow"}, "mode")
+		ports := main.AddIntSliceOption(nil, "ports", "", []int{80}, "ports")
+		waits := main.AddDurationSliceOption(nil, "waits", "", nil, "waits")
+		main.AddToFlags(flags)
+
+		Expect(flags.Lookup("mode").Usage).To(Equal("mode (one of fast, slow)"))
+		Expect(flags.Parse([]string{"--labels=x=y,z=1", "--labels=w=2", "--ratio=0.25", "--limit=500Mi",
+			"--mode=slow", "--ports=8080,8443", "--waits=1s", "--waits=1m"})).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+
+		Expect(*labels).To(Equal(map[string]string{"x": "y", "z": "1", "w": "2"}))
+		Expect(*ratio).To(Equal(0.25))
+		Expect(limit.String()).To(Equal("500Mi"))
+		Expect(*mode).To(Equal("slow"))
+		Expect(*ports).To(Equal([]int{8080, 8443}))
+		Expect(*waits).To(Equal([]time.Duration{time.Second, time.Minute}))
+
+		Expect(main.GetOption("labels").StringMap()).To(Equal(*labels))
+		Expect(main.GetOption("ratio").FloatValue()).To(Equal(0.25))
+		q := main.GetOption("limit").QuantityValue()
+		Expect(q.String()).To(Equal("500Mi"))
+		Expect(main.GetOption("mode").StringValue()).To(Equal("slow"))
+		Expect(main.GetOption("ports").IntSlice()).To(Equal([]int{8080, 8443}))
+		Expect(main.GetOption("waits").DurationSlice()).To(Equal([]time.Duration{time.Second, time.Minute}))
+	})
+
+	It("should reject invalid values", func() {
+		main.AddEnumOption(nil, "mode", "", "fast", []string{"fast", "slow"}, "mode")
+		main.AddQuantityOption(nil, "limit", "", resource.Quantity{}, "limit")
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--mode=medium"})).NotTo(Succeed())
+		Expect(flags.Parse([]string{"--limit=lots"})).NotTo(Succeed())
+	})
+
+	It("should handle typed options in config files and the environment", func() {
+		labels := main.AddStringMapOption(nil, "labels", "", map[string]string{"a": "b"}, "labels")
+		ports := main.AddIntSliceOption(nil, "ports", "", nil, "ports")
+		limit := main.AddQuantityOption(nil, "limit", "", resource.Quantity{}, "limit")
+		main.AddToFlags(flags)
+
+		Expect(os.Setenv("TEST_PORTS", "1,2")).To(Succeed())
+		DeferCleanup(os.Unsetenv, "TEST_PORTS")
+		Expect(config.MergeEnvironment("test", flags, false)).To(Succeed())
+		Expect(config.MergeConfig([]byte("labels:\n  app: test\n  tier: 1\nports: [3]\nlimit: 2Gi\n"), flags, false)).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+
+		Expect(*labels).To(Equal(map[string]string{"app": "test", "tier": "1"}))
+		Expect(*ports).To(Equal([]int{1, 2}))
+		Expect(limit.String()).To(Equal("2Gi"))
+	})
+
+	It("should keep enum values containing commas", func() {
+		mode := main.AddEnumOption(nil, "mode", "", "a,b", []string{"a,b", "c"}, "mode")
+		main.AddToFlags(flags)
+
+		Expect(flags.Lookup("mode").Usage).To(Equal("mode (one of a,b, c)"))
+		Expect(flags.Parse([]string{"--mode=a"})).NotTo(Succeed())
+		Expect(flags.Parse([]string{"--mode=c"})).To(Succeed())
+		Expect(flags.Parse([]string{"--mode=a,b"})).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(*mode).To(Equal("a,b"))
+	})
+
+	It("should treat appended and replaced string map entries as changed", func() {
+		labels := main.AddStringMapOption(nil, "labels", "", map[string]string{"a": "b"}, "labels")
+		tiers := main.AddStringMapOption(nil, "tiers", "", map[string]string{"a": "b"}, "tiers")
+		main.AddToFlags(flags)
+
+		Expect(flags.Lookup("labels").Value.(pflag.SliceValue).Append("app=test")).To(Succeed())
+		Expect(flags.Set("labels", "tier=1")).To(Succeed())
+		Expect(*labels).To(Equal(map[string]string{"a": "b", "app": "test", "tier": "1"}))
+
+		Expect(flags.Lookup("tiers").Value.(pflag.SliceValue).Replace([]string{"app=test"})).To(Succeed())
+		Expect(flags.Set("tiers", "tier=1")).To(Succeed())
+		Expect(*tiers).To(Equal(map[string]string{"app": "test", "tier": "1"}))
+	})
+
+	It("should share enum options with the same values", func() {
+		set1 := config.NewSharedOptionSet("c1", "c1")
+		set1.AddEnumOption(nil, "mode", "", "fast", []string{"fast", "slow"}, "mode")
+		set2 := config.NewSharedOptionSet("c2", "c2")
+		mode := set2.AddEnumOption(nil, "mode", "", "fast", []string{"fast", "slow"}, "mode")
+		main.AddSource("c1", set1)
+		main.AddSource("c2", set2)
+		main.AddToFlags(flags)
+
+		Expect(flags.Parse([]string{"--mode=slow"})).To(Succeed())
+		Expect(main.Evaluate()).To(Succeed())
+		Expect(*mode).To(Equal("slow"))
+	})
+})
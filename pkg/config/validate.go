@@ -0,0 +1,344 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ValueValidator validates the value of an option.
+type ValueValidator func(value interface{}) error
+
+// AddValueValidator sets a validator for the value of an option of an option
+// set. It must be called before the options are propagated to an outer option
+// set. The validator is called when the option set is evaluated, on startup
+// and when the config file is reloaded, and by the validation of a config
+// file.
+func AddValueValidator(set Options, name string, validator ValueValidator) {
+	o := set.GetOption(name)
+	if o == nil {
+		panic(fmt.Sprintf("option %q not found", name))
+	}
+	o.Validator = validator
+}
+
+// chainValidators combines the validators of options sharing a value.
+func chainValidators(a, b ValueValidator) ValueValidator {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return func(value interface{}) error {
+		if err := a(value); err != nil {
+			return err
+		}
+		return b(value)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ConfigProblem is a problem found in a config file.
+type ConfigProblem struct {
+	File    string
+	Line    int
+	Column  int
+	Path    string
+	Message string
+}
+
+func (this *ConfigProblem) String() string {
+	loc := this.File
+	if this.Line > 0 {
+		loc = fmt.Sprintf("%s:%d:%d", loc, this.Line, this.Column)
+	}
+	if this.Path != "" {
+		return fmt.Sprintf("%s: %s: %s", loc, this.Path, this.Message)
+	}
+	return fmt.Sprintf("%s: %s", loc, this.Message)
+}
+
+// ConfigProblems is a list of problems found in a config file.
+// It can be used as error.
+type ConfigProblems []*ConfigProblem
+
+func (this ConfigProblems) Error() string {
+	lines := make([]string, len(this))
+	for i, p := range this {
+		lines[i] = p.String()
+	}
+	return fmt.Sprintf("invalid config:\n  %s", strings.Join(lines, "\n  "))
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ConfigValidation validates a yaml or json config file for a flag set. All
+// problems are collected with their location in the file: unknown keys (with
+// suggestions for similar option names) and values not matching the type of
+// the option. After the config has been merged into the flag set, the option
+// values can be validated with ValidateOptions.
+type ConfigValidation struct {
+	File      string
+	Problems  ConfigProblems
+	flags     *pflag.FlagSet
+	locations map[string]*yaml.Node
+}
+
+// ValidateConfigFile validates a yaml or json config file for a flag set.
+func ValidateConfigFile(fileName string, flags *pflag.FlagSet) *ConfigValidation {
+	data, err := os.ReadFile(filepath.Clean(fileName))
+	if err != nil {
+		v := &ConfigValidation{File: fileName, flags: flags, locations: map[string]*yaml.Node{}}
+		v.add(nil, "", "%s", err)
+		return v
+	}
+	return ValidateConfig(fileName, data, flags)
+}
+
+// ValidateConfig validates yaml or json config data for a flag set.
+// The file name is only used to report the problems.
+func ValidateConfig(fileName string, data []byte, flags *pflag.FlagSet) *ConfigValidation {
+	this := &ConfigValidation{File: fileName, flags: flags, locations: map[string]*yaml.Node{}}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		this.add(nil, "", "%s", err)
+		return this
+	}
+	if len(doc.Content) == 0 {
+		return this
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		this.add(root, "", "config must be an object")
+		return this
+	}
+	this.validateObject("", root)
+	return this
+}
+
+// Error returns the problems as error or nil if no problem has been found.
+func (this *ConfigValidation) Error() error {
+	if len(this.Problems) == 0 {
+		return nil
+	}
+	return this.Problems
+}
+
+func (this *ConfigValidation) add(node *yaml.Node, path string, msgfmt string, args ...interface{}) {
+	p := &ConfigProblem{File: this.File, Path: path, Message: fmt.Sprintf(msgfmt, args...)}
+	if node != nil {
+		p.Line = node.Line
+		p.Column = node.Column
+	}
+	this.Problems = append(this.Problems, p)
+}
+
+func (this *ConfigValidation) validateObject(prefix string, node *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		name := key.Value
+		if name == "_" {
+			if prefix == "" {
+				this.add(key, name, "name '_' not possible at top level")
+				continue
+			}
+			name = prefix
+		} else if prefix != "" {
+			name = prefix + "." + name
+		}
+		this.validateValue(name, key, value)
+	}
+}
+
+func (this *ConfigValidation) validateValue(name string, key, value *yaml.Node) {
+	flag := this.flags.Lookup(name)
+	switch value.Kind {
+	case yaml.AliasNode:
+		this.validateValue(name, key, value.Alias)
+		return
+	case yaml.MappingNode:
+		if flag != nil && flag.Value.Type() == "stringMap" {
+			this.locations[name] = key
+			for i := 0; i+1 < len(value.Content); i += 2 {
+				if value.Content[i+1].Kind != yaml.ScalarNode {
+					this.add(value.Content[i+1], name+"."+value.Content[i].Value, "expected a string value")
+				}
+			}
+			return
+		}
+		if !this.hasPrefix(name) {
+			this.unknown(key, name)
+			return
+		}
+		this.validateObject(name, value)
+		return
+	}
+
+	if flag == nil {
+		if this.hasPrefix(name) {
+			this.add(key, name, "expected an object")
+		} else {
+			this.unknown(key, name)
+		}
+		return
+	}
+	this.locations[name] = key
+	if value.Kind == yaml.SequenceNode {
+		if !isListType(flag.Value.Type()) {
+			this.add(value, name, "expected a single value of type %s", flag.Value.Type())
+			return
+		}
+		for _, item := range value.Content {
+			if item.Kind != yaml.ScalarNode {
+				this.add(item, name, "expected a %s value", flag.Value.Type())
+				continue
+			}
+			if err := checkValue(flag, item.Value); err != nil {
+				this.add(item, name, "%s", err)
+			}
+		}
+		return
+	}
+	if value.Kind == yaml.ScalarNode {
+		if err := checkValue(flag, value.Value); err != nil {
+			this.add(value, name, "%s", err)
+		}
+	}
+}
+
+func (this *ConfigValidation) hasPrefix(name string) bool {
+	found := false
+	this.flags.VisitAll(func(f *pflag.Flag) {
+		found = found || strings.HasPrefix(f.Name, name+".")
+	})
+	return found
+}
+
+func (this *ConfigValidation) unknown(key *yaml.Node, name string) {
+	if s := this.suggest(name); s != "" {
+		this.add(key, name, "unknown option (did you mean %q?)", s)
+	} else {
+		this.add(key, name, "unknown option")
+	}
+}
+
+// suggest returns the most similar option name, if it is similar enough.
+func (this *ConfigValidation) suggest(name string) string {
+	best := ""
+	distance := len(name)/3 + 1
+	var names []string
+	this.flags.VisitAll(func(f *pflag.Flag) {
+		names = append(names, f.Name)
+	})
+	sort.Strings(names)
+	for _, n := range names {
+		if d := levenshtein(name, n); d < distance || d == distance && best == "" {
+			best, distance = n, d
+		}
+	}
+	return best
+}
+
+// ValidateOptions validates the values of the options of an option set by
+// calling the value validators of the options and evaluating the option
+// set. Problems for options given in the config file are reported with
+// their location.
+func (this *ConfigValidation) ValidateOptions(set OptionSet) {
+	var names []string
+	set.VisitOptions(func(o *ArbitraryOption) bool {
+		if o.Validator != nil {
+			names = append(names, o.Name)
+		}
+		return true
+	})
+	sort.Strings(names)
+	for _, n := range names {
+		o := set.GetOption(n)
+		if err := o.Validator(o.Value()); err != nil {
+			this.add(this.locations[n], n, "%s", err)
+		}
+	}
+	if len(this.Problems) == 0 {
+		if err := set.Evaluate(); err != nil {
+			this.add(nil, "", "%s", err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func isListType(t string) bool {
+	return strings.HasSuffix(t, "Array") || strings.HasSuffix(t, "Slice") || t == "stringMap"
+}
+
+// checkValue checks a single value for the type of a flag.
+func checkValue(flag *pflag.Flag, value string) error {
+	var err error
+	switch t := flag.Value.Type(); t {
+	case "int", "intSlice":
+		_, err = strconv.ParseInt(value, 0, 64)
+	case "uint":
+		_, err = strconv.ParseUint(value, 0, 64)
+	case "float64":
+		_, err = strconv.ParseFloat(value, 64)
+	case "bool":
+		_, err = strconv.ParseBool(value)
+	case "duration", "durationSlice":
+		_, err = time.ParseDuration(value)
+	case "quantity":
+		_, err = resource.ParseQuantity(value)
+	case "stringMap":
+		_, err = parseStringMapEntries([]string{value})
+	default:
+		if e, ok := flag.Value.(*enumValue); ok {
+			for _, a := range e.allowed {
+				if a == value {
+					return nil
+				}
+			}
+			return fmt.Errorf("invalid value %q (possible values: %s)", value, strings.Join(e.allowed, ", "))
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("invalid value %q for type %s", value, flag.Value.Type())
+	}
+	return nil
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
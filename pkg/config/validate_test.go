@@ -0,0 +1,76 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package config_test
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validation", func() {
+	var (
+		main  *config.DefaultOptionSet
+		flags *pflag.FlagSet
+	)
+
+	BeforeEach(func() {
+		main = config.NewDefaultOptionSet("main", "")
+		ctrl := config.NewSharedOptionSet("c1", "c1")
+		ctrl.AddIntOption(nil, "size", "", 1, "size")
+		ctrl.AddEnumOption(nil, "mode", "", "fast", []string{"fast", "slow"}, "mode")
+		ctrl.AddStringMapOption(nil, "labels", "", nil, "labels")
+		ctrl.AddIntSliceOption(nil, "ports", "", nil, "ports")
+		config.AddValueValidator(ctrl, "size", func(v interface{}) error {
+			if v.(int) > 10 {
+				return fmt.Errorf("size too large")
+			}
+			return nil
+		})
+		main.AddSource("c1", ctrl)
+		main.AddStringOption(nil, "name", "", "", "name")
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		main.AddToFlags(flags)
+	})
+
+	It("should accept a valid config", func() {
+		v := config.ValidateConfig("test.yaml", []byte("name: cm\nc1:\n  size: 3\n  mode: slow\n  labels:\n    a: b\n  ports: [1, 2]\n"), flags)
+		Expect(v.Error()).To(Succeed())
+	})
+
+	It("should report all problems with locations", func() {
+		v := config.ValidateConfig("test.yaml", []byte("nme: cm\nc1:\n  size: many\n  mode: medium\n  labels:\n    a: [b]\n  ports: [1, x]\nname: [a]\nc2:\n  size: 1\n"), flags)
+		Expect(v.Problems).To(HaveLen(7))
+		Expect(v.Problems[0].String()).To(Equal(`test.yaml:1:1: nme: unknown option (did you mean "name"?)`))
+		Expect(v.Problems[1].String()).To(Equal(`test.yaml:3:9: c1.size: invalid value "many" for type int`))
+		Expect(v.Problems[2].String()).To(Equal(`test.yaml:4:9: c1.mode: invalid value "medium" (possible values: fast, slow)`))
+		Expect(v.Problems[3].String()).To(Equal(`test.yaml:6:8: c1.labels.a: expected a string value`))
+		Expect(v.Problems[4].String()).To(Equal(`test.yaml:7:14: c1.ports: invalid value "x" for type intSlice`))
+		Expect(v.Problems[5].String()).To(Equal(`test.yaml:8:7: name: expected a single value of type string`))
+		Expect(v.Problems[6].String()).To(Equal(`test.yaml:9:1: c2: unknown option`))
+	})
+
+	It("should validate option values", func() {
+		data := []byte("c1:\n  size: 20\n")
+		v := config.ValidateConfig("test.yaml", data, flags)
+		Expect(v.Error()).To(Succeed())
+		Expect(config.MergeConfig(data, flags, false)).To(Succeed())
+		v.ValidateOptions(main)
+		Expect(v.Problems).To(HaveLen(1))
+		Expect(v.Problems[0].String()).To(Equal("test.yaml:2:3: c1.size: size too large"))
+	})
+
+	It("should reject invalid option values on evaluation", func() {
+		Expect(config.MergeConfig([]byte("c1:\n  size: 20\n"), flags, false)).To(Succeed())
+		Expect(main.Evaluate()).To(MatchError(`invalid value for option "c1.size": size too large`))
+	})
+})
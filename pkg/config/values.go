@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// string maps
+
+// stringMapValue is a flag value for a string map. Values are given as comma
+// separated key=value pairs. Like for slices the first setting replaces the
+// default, subsequent settings add entries.
+type stringMapValue struct {
+	value   *map[string]string
+	changed bool
+}
+
+var _ pflag.SliceValue = (*stringMapValue)(nil)
+
+func newStringMapValue(def map[string]string, p *map[string]string) *stringMapValue {
+	*p = copyStringMap(def)
+	return &stringMapValue{value: p}
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func parseStringMapEntries(entries []string) (map[string]string, error) {
+	m := map[string]string{}
+	for _, e := range entries {
+		if e == "" {
+			continue
+		}
+		kv := strings.SplitN(e, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%s must be formatted as key=value", e)
+		}
+		m[kv[0]] = kv[1]
+	}
+	return m, nil
+}
+
+func (this *stringMapValue) Set(s string) error {
+	m, err := parseStringMapEntries(strings.Split(s, ","))
+	if err != nil {
+		return err
+	}
+	if !this.changed || *this.value == nil {
+		*this.value = m
+	} else {
+		for k, v := range m {
+			(*this.value)[k] = v
+		}
+	}
+	this.changed = true
+	return nil
+}
+
+func (this *stringMapValue) Type() string {
+	return "stringMap"
+}
+
+func (this *stringMapValue) String() string {
+	return "[" + strings.Join(this.GetSlice(), ",") + "]"
+}
+
+func (this *stringMapValue) Append(s string) error {
+	m, err := parseStringMapEntries([]string{s})
+	if err != nil {
+		return err
+	}
+	if *this.value == nil {
+		*this.value = map[string]string{}
+	}
+	for k, v := range m {
+		(*this.value)[k] = v
+	}
+	this.changed = true
+	return nil
+}
+
+func (this *stringMapValue) Replace(entries []string) error {
+	m, err := parseStringMapEntries(entries)
+	if err != nil {
+		return err
+	}
+	*this.value = m
+	this.changed = true
+	return nil
+}
+
+func (this *stringMapValue) GetSlice() []string {
+	entries := []string{}
+	for k, v := range *this.value {
+		entries = append(entries, k+"="+v)
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// quantities
+
+// quantityValue is a flag value for a resource quantity.
+type quantityValue struct {
+	value *resource.Quantity
+}
+
+func newQuantityValue(def resource.Quantity, p *resource.Quantity) *quantityValue {
+	*p = def.DeepCopy()
+	return &quantityValue{value: p}
+}
+
+func (this *quantityValue) Set(s string) error {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return err
+	}
+	*this.value = q
+	return nil
+}
+
+func (this *quantityValue) Type() string {
+	return "quantity"
+}
+
+func (this *quantityValue) String() string {
+	return this.value.String()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// enums
+
+// enumValue is a string flag value restricted to a set of allowed values.
+type enumValue struct {
+	value   *string
+	allowed []string
+}
+
+func newEnumValue(def string, p *string, allowed []string) *enumValue {
+	*p = def
+	return &enumValue{value: p, allowed: allowed}
+}
+
+func (this *enumValue) Set(s string) error {
+	for _, a := range this.allowed {
+		if a == s {
+			*this.value = s
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value %q (possible values: %s)", s, strings.Join(this.allowed, ", "))
+}
+
+// Type returns string to be usable with the string accessors of the flag set.
+func (this *enumValue) Type() string {
+	return "string"
+}
+
+func (this *enumValue) String() string {
+	return *this.value
+}
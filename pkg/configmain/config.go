@@ -27,7 +27,17 @@ func NewConfig() *Config {
 func (this *Config) Evaluate() error {
 	if !this.evaluated {
 		this.evaluated = true
-		return this.DefaultOptionSet.Evaluate()
+		return this.Reevaluate()
 	}
 	return nil
 }
+
+// Reevaluate evaluates the settings again after reloadable
+// options have been changed by reloading the config file.
+// Option values given as reference are resolved before the evaluation.
+func (this *Config) Reevaluate() error {
+	if err := config.ResolveValues(this); err != nil {
+		return err
+	}
+	return this.DefaultOptionSet.Evaluate()
+}
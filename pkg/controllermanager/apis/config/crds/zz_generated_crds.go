@@ -0,0 +1,105 @@
+/*
+SPDX-FileCopyrightText: YEAR SAP SE or an SAP affiliate company and Gardener contributors
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package crds
+
+import (
+	"github.com/gardener/controller-manager-library/pkg/resources/apiextensions"
+	"github.com/gardener/controller-manager-library/pkg/utils"
+)
+
+var registry = apiextensions.NewRegistry()
+
+func init() {
+	var data string
+	data = `
+
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  annotations:
+    controller-gen.kubebuilder.io/version: v0.2.9
+  creationTimestamp: null
+  name: controllermanagerconfigurations.controllermanager.gardener.cloud
+spec:
+  group: controllermanager.gardener.cloud
+  names:
+    kind: ControllerManagerConfiguration
+    listKind: ControllerManagerConfigurationList
+    plural: controllermanagerconfigurations
+    shortNames:
+    - cmconfig
+    singular: controllermanagerconfiguration
+  scope: Namespaced
+  versions:
+  - additionalPrinterColumns:
+    - jsonPath: .status.state
+      name: State
+      type: string
+    - jsonPath: .metadata.creationTimestamp
+      name: Age
+      type: date
+    name: v1alpha1
+    schema:
+      openAPIV3Schema:
+        description: ControllerManagerConfiguration configures a controller manager like a config file.
+        properties:
+          apiVersion:
+            description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+            type: string
+          kind:
+            description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+            type: string
+          metadata:
+            type: object
+          spec:
+            description: Spec contains the option settings. Like in a config file the keys mirror the option tree of the controller manager.
+            type: object
+            x-kubernetes-preserve-unknown-fields: true
+          status:
+            description: ControllerManagerConfigurationStatus reports the usage of a configuration.
+            properties:
+              config:
+                description: Config is the effective configuration, structured like the config file. Values given as reference are reported as reference, values of sensitive options are redacted.
+                type: object
+                x-kubernetes-preserve-unknown-fields: true
+              observedGeneration:
+                description: ObservedGeneration is the generation of the configuration used by the controller manager.
+                format: int64
+                type: integer
+              options:
+                additionalProperties:
+                  type: string
+                description: Options maps the names of the options explicitly set by any configuration source to this source (flag, environment, config file or config object).
+                type: object
+              problems:
+                description: Problems lists the validation errors of an invalid configuration.
+                items:
+                  type: string
+                type: array
+              state:
+                description: State is Valid or Invalid.
+                type: string
+            type: object
+        type: object
+    served: true
+    storage: true
+    subresources:
+      status: {}
+status:
+  acceptedNames:
+    kind: ""
+    plural: ""
+  conditions: []
+  storedVersions: []
+  `
+	utils.Must(registry.RegisterCRD(data))
+}
+
+func AddToRegistry(r apiextensions.Registry) {
+	registry.AddToRegistry(r)
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// StateValid indicates a configuration used by the controller manager.
+	StateValid = "Valid"
+	// StateInvalid indicates a configuration rejected by the controller manager.
+	StateInvalid = "Invalid"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ControllerManagerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata
+	// More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ControllerManagerConfiguration `json:"items"`
+}
+
+// +kubebuilder:storageversion
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,path=controllermanagerconfigurations,shortName=cmconfig,singular=controllermanagerconfiguration
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=State,JSONPath=".status.state",type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=".metadata.creationTimestamp",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControllerManagerConfiguration configures a controller manager
+// like a config file.
+type ControllerManagerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the option settings. Like in a config file the keys
+	// mirror the option tree of the controller manager.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Spec *runtime.RawExtension `json:"spec,omitempty"`
+	// +optional
+	Status ControllerManagerConfigurationStatus `json:"status,omitempty"`
+}
+
+// ControllerManagerConfigurationStatus reports the usage of a configuration.
+type ControllerManagerConfigurationStatus struct {
+	// ObservedGeneration is the generation of the configuration used
+	// by the controller manager.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// State is Valid or Invalid.
+	// +optional
+	State string `json:"state,omitempty"`
+	// Problems lists the validation errors of an invalid configuration.
+	// +optional
+	Problems []string `json:"problems,omitempty"`
+	// Options maps the names of the options explicitly set by any
+	// configuration source to this source (flag, environment, config file
+	// or config object).
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+	// Config is the effective configuration, structured like the config
+	// file. Values given as reference are reported as reference, values of
+	// sensitive options are redacted.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
+}
@@ -0,0 +1,11 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// +k8s:deepcopy-gen=package
+
+//go:generate ../../../../../hack/generate-crds
+
+// Package v1alpha1 contains the API resources used to configure a controller manager.
+// +groupName=controllermanager.gardener.cloud
+package v1alpha1 // import "github.com/gardener/controller-manager-library/pkg/controllermanager/apis/config/v1alpha1"
@@ -8,7 +8,9 @@ package cert
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/gardener/controller-manager-library/pkg/certmgmt"
@@ -16,6 +18,7 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/certs"
 	"github.com/gardener/controller-manager-library/pkg/certs/access"
 	"github.com/gardener/controller-manager-library/pkg/certs/file"
+	"github.com/gardener/controller-manager-library/pkg/certs/issuer"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
@@ -40,13 +43,23 @@ func CreateSecretCertificateSource(ctx context.Context, logger logger.LogContext
 		if len(hosts) == 0 {
 			return nil, fmt.Errorf("hosts for managed certificate secret required")
 		}
-		logger.Infof("managing certificate")
+		algo, err := certmgmt.ParseKeyAlgorithm(cfg.KeyAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("managing certificate (%s keys)", algo)
 		certcfg = &certmgmt.Config{
 			CommonName:   cfg.CommonName,
 			Organization: []string{cfg.Organization},
 			Validity:     10 * 24 * time.Hour,
 			Rest:         24 * time.Hour,
 			Hosts:        hosts,
+			KeyAlgorithm: algo,
+			KeySize:      cfg.KeySize,
+
+			CARenewal:          cfg.CARenewal,
+			CAPropagationDelay: cfg.CAPropagationDelay,
+			ClientUsage:        cfg.ClientUsage,
 		}
 	} else {
 		logger.Infof("externally managed certificate")
@@ -54,6 +67,44 @@ func CreateSecretCertificateSource(ctx context.Context, logger logger.LogContext
 	return access.New(ctx, logger, secret, certcfg)
 }
 
+func CreateIssuerCertificateSource(ctx context.Context, logger logger.LogContext, cluster cluster.Interface, namespace string, cfg *CertConfig) (certs.CertificateSource, error) {
+	hosts := certmgmt.NewCompoundHosts()
+	for _, h := range cfg.Hostnames {
+		hosts.Add(certmgmt.NewDNSName(h))
+	}
+	if cfg.Service != "" {
+		hosts.Add(certmgmt.NewServiceHosts(cfg.Service, namespace))
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("hosts for issued certificate required")
+	}
+	spec := &issuer.Spec{
+		Secret:      resources.NewObjectName(namespace, cfg.Secret),
+		CommonName:  cfg.CommonName,
+		Hosts:       hosts,
+		IssuerName:  cfg.Issuer,
+		IssuerKind:  cfg.IssuerKind,
+		IssuerGroup: cfg.IssuerGroup,
+		Timeout:     cfg.IssuerTimeout,
+	}
+	if cfg.CACertFile != "" {
+		data, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read ca file for issued certificate: %s", err)
+		}
+		spec.CABundle = data
+	}
+	logger.Infof("using certificate issued by %s %q for ips: %v, dns: %v", cfg.IssuerKind, cfg.Issuer, hosts.GetIPs(), hosts.GetDNSNames())
+	source, err := issuer.New(ctx, logger, cluster, spec)
+	if err != nil {
+		if errors.Is(err, issuer.ErrNoCACert) {
+			return nil, fmt.Errorf("%s: set option %scacertfile for %s %q", err, cfg.prefix, cfg.IssuerKind, cfg.Issuer)
+		}
+		return nil, err
+	}
+	return source, nil
+}
+
 func CreateFileCertificateSource(ctx context.Context, logger logger.LogContext, cfg *CertConfig) (certs.CertificateSource, error) {
 	return file.New(ctx, logger, cfg.CertFile, cfg.KeyFile, cfg.CACertFile, cfg.CAKeyFile)
 }
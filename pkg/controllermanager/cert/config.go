@@ -10,13 +10,20 @@ package cert
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
+	"k8s.io/client-go/util/cert"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
 	certsecret "github.com/gardener/controller-manager-library/pkg/certmgmt/secret"
 	"github.com/gardener/controller-manager-library/pkg/certs"
+	"github.com/gardener/controller-manager-library/pkg/certs/issuer"
 	"github.com/gardener/controller-manager-library/pkg/config"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
 	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/server"
 )
 
 type CertConfig struct {
@@ -34,6 +41,25 @@ type CertConfig struct {
 	CACertFile string
 	CAKeyFile  string
 
+	KeyAlgorithm string
+	KeySize      int
+
+	CARenewal          time.Duration
+	CAPropagationDelay time.Duration
+	ClientUsage        bool
+
+	Issuer        string
+	IssuerKind    string
+	IssuerGroup   string
+	IssuerTimeout time.Duration
+
+	ClientCAFile       string
+	ClientAuthRequired bool
+	ClientNames        []string
+
+	ExpiryThreshold   time.Duration
+	MaxUpdateFailures int
+
 	disableSecretMaintenance bool
 }
 
@@ -52,12 +78,50 @@ func (this *CertConfig) AddOptionsToSet(set config.OptionSet) {
 		set.AddStringArrayOption(&this.Hostnames, this.prefix+"hostname", "", nil, fmt.Sprintf("hostname to use for %s registration", this.name))
 		set.AddStringOption(&this.CommonName, this.prefix+"commonname", "", this.CommonName, fmt.Sprintf("%s server common name", this.name))
 		set.AddStringOption(&this.Organization, this.prefix+"organization", "", this.Organization, fmt.Sprintf("%s server organization", this.name))
+		set.AddEnumOption(&this.KeyAlgorithm, this.prefix+"key-algorithm", "", string(certmgmt.KeyAlgorithmRSA), certmgmt.KeyAlgorithms, fmt.Sprintf("algorithm of generated keys for %s server", this.name))
+		set.AddIntOption(&this.KeySize, this.prefix+"key-size", "", 0, fmt.Sprintf("size of generated keys for %s server (RSA: modulus size, default 3072, ECDSA: curve size 256 or 384, default 256)", this.name))
+		set.AddDurationOption(&this.CARenewal, this.prefix+"ca-renewal", "", certmgmt.DefaultCARenewal, fmt.Sprintf("remaining validity of CA for %s server starting its rotation", this.name))
+		set.AddDurationOption(&this.CAPropagationDelay, this.prefix+"ca-propagation-delay", "", time.Hour, fmt.Sprintf("time a new CA for %s server is published before it is used", this.name))
+		set.AddStringOption(&this.Issuer, this.prefix+"issuer", "", "", fmt.Sprintf("name of cert-manager issuer for %s server certificate (maintained in secret)", this.name))
+		set.AddStringOption(&this.IssuerKind, this.prefix+"issuer-kind", "", issuer.DefaultIssuerKind, fmt.Sprintf("kind of cert-manager issuer for %s server certificate", this.name))
+		set.AddStringOption(&this.IssuerGroup, this.prefix+"issuer-group", "", issuer.DefaultIssuerGroup, fmt.Sprintf("API group of cert-manager issuer for %s server certificate", this.name))
+		set.AddDurationOption(&this.IssuerTimeout, this.prefix+"issuer-timeout", "", 5*time.Minute, fmt.Sprintf("time to wait for issuing of %s server certificate", this.name))
+		set.AddBoolOption(&this.ClientUsage, this.prefix+"client-usage", "", false, fmt.Sprintf("generated %s server certificate is usable as client certificate, also", this.name))
 	}
 	set.AddStringOption(&this.Secret, this.prefix+"secret", "", "", fmt.Sprintf("name of secret to maintain for %s server", this.name))
 	set.AddStringOption(&this.CertFile, this.prefix+"certfile", "", "", fmt.Sprintf("%s server certificate file", this.name))
 	set.AddStringOption(&this.KeyFile, this.prefix+"keyfile", "", "", fmt.Sprintf("%s server certificate key file", this.name))
 	set.AddStringOption(&this.CACertFile, this.prefix+"cacertfile", "", "", fmt.Sprintf("%s server ca certificate file", this.name))
 	set.AddStringOption(&this.CAKeyFile, this.prefix+"cakeyfile", "", "", fmt.Sprintf("%s server ca certificate key file", this.name))
+	set.AddStringOption(&this.ClientCAFile, this.prefix+"client-cafile", "", "", fmt.Sprintf("ca bundle file to verify client certificates for %s server", this.name))
+	set.AddBoolOption(&this.ClientAuthRequired, this.prefix+"client-auth-required", "", false, fmt.Sprintf("require client certificates for %s server", this.name))
+	set.AddDurationOption(&this.ExpiryThreshold, this.prefix+"expiry-threshold", "", 12*time.Hour, fmt.Sprintf("remaining validity of %s server certificate reported as not ready", this.name))
+	set.AddIntOption(&this.MaxUpdateFailures, this.prefix+"max-update-failures", "", 10, fmt.Sprintf("number of consecutive failed certificate updates for %s server reported as not ready (0: no limit)", this.name))
+	set.AddStringArrayOption(&this.ClientNames, this.prefix+"client-name", "", nil, fmt.Sprintf("accepted client identities (common name, subject alternative name or group:<organization>) for %s server", this.name))
+}
+
+// ClientAuthConfig returns the configuration for the verification of client
+// certificates, or nil, if no client CA file is configured.
+func (this *CertConfig) ClientAuthConfig() (*server.ClientAuthConfig, error) {
+	if this.ClientCAFile == "" {
+		if this.ClientAuthRequired || len(this.ClientNames) > 0 {
+			return nil, fmt.Errorf("client ca file required for client authentication of %s server", this.name)
+		}
+		return nil, nil
+	}
+	data, err := os.ReadFile(this.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read client ca file for %s server: %s", this.name, err)
+	}
+	if _, err := cert.ParseCertsPEM(data); err != nil {
+		return nil, fmt.Errorf("invalid client ca file %q for %s server: %s", this.ClientCAFile, this.name, err)
+	}
+	return &server.ClientAuthConfig{
+		CABundle: data,
+		CAFile:   this.ClientCAFile,
+		Required: this.ClientAuthRequired,
+		Names:    this.ClientNames,
+	}, nil
 }
 
 func OptionSourceCreator(name, prefix string, common, org string) extension.OptionSourceCreator {
@@ -84,12 +148,31 @@ func (this *CertConfig) Used() bool {
 	return false
 }
 
+// CreateAccess creates the certificate source for the configured certificate
+// file or secret. Its certificate status is registered as ready reporter.
 func (this *CertConfig) CreateAccess(ctx context.Context, logger logger.LogContext, cluster cluster.Interface, namespace string, keys ...certsecret.Keys) (certs.CertificateSource, error) {
-	if this.CertFile != "" {
-		return CreateFileCertificateSource(ctx, logger, this)
+	var source certs.CertificateSource
+	var err error
+	switch {
+	case this.CertFile != "":
+		source, err = CreateFileCertificateSource(ctx, logger, this)
+	case this.Issuer != "":
+		if this.Secret == "" {
+			return nil, fmt.Errorf("secret name required for certificate issued by %s %q", this.IssuerKind, this.Issuer)
+		}
+		source, err = CreateIssuerCertificateSource(ctx, logger, cluster, namespace, this)
+	case this.Secret != "":
+		source, err = CreateSecretCertificateSource(ctx, logger, cluster, namespace, this, keys...)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
 	}
-	if this.Secret != "" {
-		return CreateSecretCertificateSource(ctx, logger, cluster, namespace, this, keys...)
+	name := this.CertFile
+	if name == "" {
+		name = namespace + "/" + this.Secret
 	}
-	return nil, nil
+	registerReadiness(logger, "certificate/"+name, source, this)
+	return source, nil
 }
@@ -0,0 +1,70 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package cert
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gardener/controller-manager-library/pkg/certs"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
+)
+
+// certReadiness reports a certificate source as not ready, if its
+// certificate is within a threshold of expiry or updates keep failing.
+type certReadiness struct {
+	lock        sync.Mutex
+	logger      logger.LogContext
+	source      certs.StatusSource
+	threshold   time.Duration
+	maxFailures int
+	reason      string
+}
+
+func registerReadiness(logger logger.LogContext, name string, source certs.CertificateSource, cfg *CertConfig) {
+	s, ok := source.(certs.StatusSource)
+	if !ok {
+		return
+	}
+	ready.RegisterCheck(name, &certReadiness{
+		logger:      logger,
+		source:      s,
+		threshold:   cfg.ExpiryThreshold,
+		maxFailures: cfg.MaxUpdateFailures,
+	})
+}
+
+func (this *certReadiness) IsReady() bool {
+	return this.Check().Healthy
+}
+
+func (this *certReadiness) Check() healthz.Status {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	status := this.source.GetCertificateStatus()
+	reason := ""
+	switch {
+	case status.NotAfter.IsZero():
+		reason = "no certificate"
+	case time.Now().Add(this.threshold).After(status.NotAfter):
+		reason = "certificate expires at " + status.NotAfter.Format(time.RFC3339)
+	case this.maxFailures > 0 && status.Failures >= this.maxFailures:
+		reason = "certificate update failed: " + status.LastError
+	}
+	if reason != this.reason {
+		if reason != "" {
+			this.logger.Warnf("not ready: %s", reason)
+		} else {
+			this.logger.Infof("certificate ready again")
+		}
+		this.reason = reason
+	}
+	return healthz.NewStatus("", reason)
+}
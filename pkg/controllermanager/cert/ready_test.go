@@ -0,0 +1,158 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package cert
+
+import (
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/certs"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+type statusSource struct {
+	status certs.CertificateStatus
+}
+
+func (this *statusSource) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return nil, nil
+}
+
+func (this *statusSource) GetCertificateInfo() certmgmt.CertificateInfo {
+	return nil
+}
+
+func (this *statusSource) GetCertificateStatus() certs.CertificateStatus {
+	return this.status
+}
+
+// plainSource is a certificate source without status.
+type plainSource struct {
+	certs.CertificateSource
+}
+
+type recordingLogger struct {
+	logger.LogContext
+	lock     sync.Mutex
+	messages []string
+}
+
+func (this *recordingLogger) Infof(msgfmt string, args ...interface{}) {
+	this.record("info: "+msgfmt, args...)
+}
+
+func (this *recordingLogger) Warnf(msgfmt string, args ...interface{}) {
+	this.record("warn: "+msgfmt, args...)
+}
+
+func (this *recordingLogger) record(msgfmt string, args ...interface{}) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.messages = append(this.messages, fmt.Sprintf(msgfmt, args...))
+}
+
+func (this *recordingLogger) Messages() []string {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return append([]string{}, this.messages...)
+}
+
+var _ = Describe("certificate readiness", func() {
+	var (
+		log       *recordingLogger
+		source    *statusSource
+		readiness *certReadiness
+	)
+
+	BeforeEach(func() {
+		log = &recordingLogger{LogContext: logger.New()}
+		source = &statusSource{}
+		readiness = &certReadiness{logger: log, source: source, threshold: time.Hour, maxFailures: 3}
+	})
+
+	DescribeTable("checks the certificate status",
+		func(status func() certs.CertificateStatus, maxFailures int, reason string) {
+			source.status = status()
+			readiness.maxFailures = maxFailures
+			s := readiness.Check()
+			if reason == "" {
+				Expect(s.Healthy).To(BeTrue())
+				Expect(readiness.IsReady()).To(BeTrue())
+			} else {
+				Expect(s.Healthy).To(BeFalse())
+				Expect(s.Reason).To(HavePrefix(reason))
+				Expect(readiness.IsReady()).To(BeFalse())
+			}
+		},
+		Entry("without certificate", func() certs.CertificateStatus {
+			return certs.CertificateStatus{}
+		}, 3, "no certificate"),
+		Entry("valid certificate", func() certs.CertificateStatus {
+			return certs.CertificateStatus{NotAfter: time.Now().Add(2 * time.Hour)}
+		}, 3, ""),
+		Entry("certificate within the expiry threshold", func() certs.CertificateStatus {
+			return certs.CertificateStatus{NotAfter: time.Now().Add(30 * time.Minute)}
+		}, 3, "certificate expires at "),
+		Entry("expired certificate", func() certs.CertificateStatus {
+			return certs.CertificateStatus{NotAfter: time.Now().Add(-time.Minute)}
+		}, 3, "certificate expires at "),
+		Entry("some failed updates", func() certs.CertificateStatus {
+			return certs.CertificateStatus{NotAfter: time.Now().Add(2 * time.Hour), Failures: 2, LastError: "failed"}
+		}, 3, ""),
+		Entry("too many failed updates", func() certs.CertificateStatus {
+			return certs.CertificateStatus{NotAfter: time.Now().Add(2 * time.Hour), Failures: 3, LastError: "failed"}
+		}, 3, "certificate update failed: failed"),
+		Entry("failed updates without limit", func() certs.CertificateStatus {
+			return certs.CertificateStatus{NotAfter: time.Now().Add(2 * time.Hour), Failures: 100, LastError: "failed"}
+		}, 0, ""),
+	)
+
+	It("logs changes of the readiness only", func() {
+		source.status = certs.CertificateStatus{NotAfter: time.Now().Add(2 * time.Hour), Failures: 3, LastError: "failed"}
+		readiness.Check()
+		readiness.Check()
+		Expect(log.Messages()).To(Equal([]string{"warn: not ready: certificate update failed: failed"}))
+
+		source.status.Failures = 0
+		readiness.Check()
+		readiness.Check()
+		Expect(log.Messages()).To(Equal([]string{
+			"warn: not ready: certificate update failed: failed",
+			"info: certificate ready again",
+		}))
+	})
+
+	It("registers the readiness check for sources with status", func() {
+		cfg := &CertConfig{ExpiryThreshold: time.Hour, MaxUpdateFailures: 5}
+		registerReadiness(log, "test-cert-status", source, cfg)
+		defer ready.Unregister("test-cert-status")
+		registerReadiness(log, "test-cert-plain", &plainSource{}, cfg)
+		defer ready.Unregister("test-cert-plain")
+
+		Expect(ready.ReadyChecks.Names()).To(ContainElement("test-cert-status"))
+		Expect(ready.ReadyChecks.Names()).NotTo(ContainElement("test-cert-plain"))
+
+		source.status = certs.CertificateStatus{NotAfter: time.Now().Add(30 * time.Minute)}
+		report := ready.ReadyChecks.Execute(nil)
+		found := false
+		for _, c := range report.Checks {
+			if c.Name == "test-cert-status" {
+				found = true
+				Expect(c.Healthy).To(BeFalse())
+			}
+		}
+		Expect(found).To(BeTrue())
+	})
+})
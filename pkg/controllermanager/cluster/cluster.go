@@ -19,13 +19,13 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 
+	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/config"
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	restclient "k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 )
 
 const DEFAULT = "default"
@@ -198,7 +198,12 @@ func (this *_Cluster) WithScheme(scheme *runtime.Scheme) (Interface, error) {
 		return this, nil
 	}
 	logger.Infof("  clone cluster %q[%s] for new scheme", this.name, this.id)
-	return CreateClusterForScheme(this.ctx, this.logctx, this.definition, this.id, this.kubeConfig, scheme)
+	c, err := CreateClusterForScheme(this.ctx, this.logctx, this.definition, this.id, this.kubeConfig, scheme)
+	if err != nil {
+		return nil, err
+	}
+	c.ResourceContext().SetCacheSyncTimeout(this.rctx.GetCacheSyncTimeout())
+	return c, nil
 }
 
 func (this *_Cluster) EnforceExplicitClusterIdentity(logger logger.LogContext) error {
@@ -209,7 +214,9 @@ func (this *_Cluster) EnforceExplicitClusterIdentity(logger logger.LogContext) e
 	return nil
 }
 
-func CreateCluster(ctx context.Context, logger logger.LogContext, def Definition, id string, cfg *Config) (Interface, error) {
+// kubeconfigPath resolves the kubeconfig file configured for a cluster.
+// An empty path is used for the in-cluster config.
+func kubeconfigPath(cfg *Config) (string, error) {
 	kubeconfig := ""
 	if cfg != nil {
 		kubeconfig = cfg.KubeConfig
@@ -221,7 +228,7 @@ func CreateCluster(ctx context.Context, logger logger.LogContext, def Definition
 			ok := false
 			kubeconfig, ok = syscall.Getenv("KUBECONFIG")
 			if !ok {
-				return nil, fmt.Errorf("environment variable KUBECONFIG not set")
+				return "", fmt.Errorf("environment variable KUBECONFIG not set")
 			}
 		} else {
 			if kubeconfig == "" {
@@ -229,11 +236,42 @@ func CreateCluster(ctx context.Context, logger logger.LogContext, def Definition
 			}
 		}
 	}
+	return kubeconfig, nil
+}
+
+func CreateCluster(ctx context.Context, logger logger.LogContext, def Definition, id string, cfg *Config) (Interface, error) {
+	kubeconfig, err := kubeconfigPath(cfg)
+	if err != nil {
+		return nil, err
+	}
 	name := def.Name()
 	logger.Infof("using %q for cluster %q[%s]", kubeconfig, name, id)
-	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	build := func() (*restclient.Config, error) {
+		return restConfig(def, cfg, kubeconfig)
+	}
+
+	if cfg != nil && cfg.ReloadKubeConfig && kubeconfig != "" {
+		logger.Infof("reloading credentials for cluster %q on changes of %q", name, kubeconfig)
+		reloader, err := newCredentialsReloader(ctx, logger, name, kubeconfig, build)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cluster %q: %s", name, err)
+		}
+		return CreateClusterForScheme(ctx, logger, def, id, reloader.Config(), nil)
+	}
+
+	kubeConfig, err := build()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cluster %q: %s", name, err)
+		return nil, err
+	}
+	return CreateClusterForScheme(ctx, logger, def, id, kubeConfig, nil)
+}
+
+// restConfig builds the rest config for a cluster from a kubeconfig file
+// and the cluster options.
+func restConfig(def Definition, cfg *Config, kubeconfig string) (*restclient.Config, error) {
+	kubeConfig, err := loadKubeConfig(def, cfg, kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster %q: %s", def.Name(), err)
 	}
 	err = callExtensions(func(e Extension) error {
 		if t, ok := e.(RestConfigExtension); ok {
@@ -251,17 +289,34 @@ func CreateCluster(ctx context.Context, logger logger.LogContext, def Definition
 	if cfg.Burst > 0 {
 		kubeConfig.Burst = cfg.Burst
 	}
+	return kubeConfig, nil
+}
 
-	return CreateClusterForScheme(ctx, logger, def, id, kubeConfig, nil)
+// DefaultRestConfig builds the rest config for the default cluster from the
+// actual option settings. It can be used to access the default cluster before
+// the clusters are created.
+func DefaultRestConfig(cfg *areacfg.Config) (*restclient.Config, error) {
+	ccfg, ok := cfg.GetSource(OPTION_SOURCE + "." + DEFAULT).(*Config)
+	if !ok {
+		return nil, fmt.Errorf("no config for cluster %q", DEFAULT)
+	}
+	kubeconfig, err := kubeconfigPath(ccfg)
+	if err != nil {
+		return nil, err
+	}
+	return restConfig(ccfg.Definition, ccfg, kubeconfig)
 }
 
 func CreateClusterForScheme(ctx context.Context, logger logger.LogContext, def Definition, id string, kubeconfig *restclient.Config, scheme *runtime.Scheme) (Interface, error) {
 	cluster := &_Cluster{name: def.Name(), attributes: map[interface{}]interface{}{}, migids: utils.StringSet{}}
 
-	if def.Scheme() == nil {
+	if scheme == nil {
+		scheme = def.Scheme()
+	}
+	if scheme == nil {
 		scheme = resources.DefaultScheme()
 	}
-	if scheme != nil && def.Scheme() != scheme {
+	if def.Scheme() != scheme {
 		def = def.Configure().Scheme(scheme).Definition()
 	}
 	scheme.KnownTypes(schema.GroupVersion{Group: "discovery.k8s.io", Version: "v1beta1"})
@@ -8,6 +8,7 @@ package cluster
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gardener/controller-manager-library/pkg/config"
 	"github.com/gardener/controller-manager-library/pkg/utils"
@@ -32,6 +33,14 @@ const SUBOPTION_QPS = "qps"
 // SUBOPTION_BURST is an option to set the maximum burst to the apiserver of the cluster.
 const SUBOPTION_BURST = "burst"
 
+// SUBOPTION_RELOAD_KUBECONFIG is an option to reload the credentials of the cluster,
+// whenever the kubeconfig file or the files referenced by it change.
+const SUBOPTION_RELOAD_KUBECONFIG = "reload-kubeconfig"
+
+// SUBOPTION_CACHE_SYNC_TIMEOUT is an option to limit the time to wait for the
+// initial sync of the informer caches of the cluster.
+const SUBOPTION_CACHE_SYNC_TIMEOUT = "cache-sync-timeout"
+
 const ConditionalDeployCRDIgnoreSetAttrKey = "conditional_deploy_ignore_set"
 
 type Config struct {
@@ -44,6 +53,8 @@ type Config struct {
 	CRDsShootNoCleanupLabel bool
 	QPS                     int
 	Burst                   int
+	ReloadKubeConfig        bool
+	CacheSyncTimeout        time.Duration
 
 	migrationIds string
 
@@ -70,6 +81,8 @@ func NewConfig(def Definition) *Config {
 	cfg.AddBoolOption(&cfg.CRDsShootNoCleanupLabel, SUBOPTION_CRDS_SHOOT_NO_CLEANUP_LABEL, "", false, fmt.Sprintf("add the label 'shoot.gardener.cloud/no-cleanup=true' for CRDS deployed on cluster %s", def.Name()))
 	cfg.AddIntOption(&cfg.QPS, SUBOPTION_QPS, "", 0, fmt.Sprintf("option to set the maximum QPS to the apiserver of the cluster %s", def.Name()))
 	cfg.AddIntOption(&cfg.Burst, SUBOPTION_BURST, "", 0, fmt.Sprintf("option to set the maximum burst to the apiserver of the cluster %s", def.Name()))
+	cfg.AddBoolOption(&cfg.ReloadKubeConfig, SUBOPTION_RELOAD_KUBECONFIG, "", false, fmt.Sprintf("reload credentials for cluster %s on changes of the kubeconfig or the files referenced by it", def.Name()))
+	cfg.AddDurationOption(&cfg.CacheSyncTimeout, SUBOPTION_CACHE_SYNC_TIMEOUT, "", 0, fmt.Sprintf("maximum time to wait for the initial sync of the informer caches of cluster %s (0 = unlimited)", def.Name()))
 	_ = callExtensions(func(e Extension) error { e.ExtendConfig(def, cfg); return nil })
 	return cfg
 }
@@ -91,5 +104,8 @@ func (this *Config) Evaluate() error {
 }
 
 func (this *Config) IsConfigured() bool {
+	if opt := this.GetOption(SUBOPTION_CONTEXT); opt != nil && opt.Changed() {
+		return true
+	}
 	return this.ClusterId != "" || this.set.GetOption(this.ConfigOptionName()).Changed()
 }
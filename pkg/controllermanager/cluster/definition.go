@@ -140,6 +140,8 @@ func (this *_Definitions) create(ctx context.Context, logger logger.LogContext,
 		cluster.SetAttr(SUBOPTION_CRDS_SHOOT_NO_CLEANUP_LABEL, true)
 	}
 
+	cluster.ResourceContext().SetCacheSyncTimeout(cfg.CacheSyncTimeout)
+
 	if !cfg.MigrationIds.IsEmpty() {
 		cluster.AddMigrationIds(cfg.MigrationIds.AsArray()...)
 	}
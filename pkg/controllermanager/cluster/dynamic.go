@@ -0,0 +1,324 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/gardener/controller-manager-library/pkg/ctxutil"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// DYNAMIC_CLUSTER_KUBECONFIG_KEY is the default secret data key
+// used to read the kubeconfig of a dynamic cluster.
+const DYNAMIC_CLUSTER_KUBECONFIG_KEY = "kubeconfig"
+
+// DYNAMIC_CLUSTER_ID_KEY is the secret data key used to read the cluster
+// id of a dynamic cluster. The id is required, because it is used to
+// identify the member in the shared caches of the cluster set.
+const DYNAMIC_CLUSTER_ID_KEY = "id"
+
+// DYNAMIC_CLUSTER_RESTART_DELAY is the delay used to recreate a member
+// of a dynamic cluster set requested by DynamicClusters.Restart.
+const DYNAMIC_CLUSTER_RESTART_DELAY = 10 * time.Second
+
+// DynamicClusterHandler is notified about the members of a dynamic cluster
+// set. If ClusterAdded fails, the member is removed again and its creation
+// is retried later. If the handler stops using a member on its own, for
+// example because of a lost lease, it can request a recreation with
+// DynamicClusters.Restart.
+type DynamicClusterHandler interface {
+	ClusterAdded(cluster Interface) error
+	ClusterRemoved(cluster Interface)
+}
+
+// DynamicClusterSpec describes the secrets of a management cluster
+// used to discover dynamic clusters.
+type DynamicClusterSpec struct {
+	// Namespace is the namespace of the kubeconfig secrets.
+	Namespace string
+	// Selector restricts the secrets to the matching ones (optional).
+	Selector labels.Selector
+	// Key is the secret data key containing the kubeconfig.
+	Key string
+	// Scheme is the scheme used for the dynamic clusters (optional).
+	Scheme *runtime.Scheme
+}
+
+type dynamicMember struct {
+	cluster Interface
+	ctx     context.Context
+	hash    string
+	restart bool
+}
+
+// DynamicClusters discovers clusters by watching kubeconfig secrets on a
+// management cluster. For every secret a cluster named like the secret is
+// created. It is recreated if the kubeconfig changes and removed if the
+// secret is deleted. Members are handled sequentially and reported to
+// the configured handler.
+type DynamicClusters struct {
+	lock    sync.Mutex
+	ctx     context.Context
+	logger  logger.LogContext
+	source  Interface
+	spec    DynamicClusterSpec
+	handler DynamicClusterHandler
+	queue   workqueue.RateLimitingInterface
+	secrets map[string]*corev1.Secret
+	members map[string]*dynamicMember
+}
+
+func NewDynamicClusters(ctx context.Context, logger logger.LogContext, source Interface, spec DynamicClusterSpec, handler DynamicClusterHandler) *DynamicClusters {
+	if spec.Key == "" {
+		spec.Key = DYNAMIC_CLUSTER_KUBECONFIG_KEY
+	}
+	if spec.Scheme == nil {
+		spec.Scheme = resources.DefaultScheme()
+	}
+	return &DynamicClusters{
+		ctx:     ctx,
+		logger:  logger,
+		source:  source,
+		spec:    spec,
+		handler: handler,
+		queue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "dynamic clusters"),
+		secrets: map[string]*corev1.Secret{},
+		members: map[string]*dynamicMember{},
+	}
+}
+
+// Start starts watching the kubeconfig secrets.
+func (this *DynamicClusters) Start() error {
+	resc, err := this.source.Resources().Get(&corev1.Secret{})
+	if err != nil {
+		return err
+	}
+	var optionsFunc resources.TweakListOptionsFunc
+	if this.spec.Selector != nil && !this.spec.Selector.Empty() {
+		optionsFunc = func(options *metav1.ListOptions) {
+			options.LabelSelector = this.spec.Selector.String()
+		}
+	}
+	this.logger.Infof("watching kubeconfig secrets (key %q) in namespace %q of cluster %s for dynamic clusters",
+		this.spec.Key, this.spec.Namespace, this.source.GetName())
+
+	ctxutil.WaitGroupRun(this.ctx, this.run)
+	return resc.AddSelectedEventHandler(resources.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj resources.Object) { this.update(obj) },
+		UpdateFunc: func(_, obj resources.Object) { this.update(obj) },
+		DeleteFunc: func(obj resources.Object) { this.delete(obj.GetName()) },
+	}, this.spec.Namespace, optionsFunc)
+}
+
+// Members returns the actually active dynamic clusters.
+func (this *DynamicClusters) Members() map[string]Interface {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	result := map[string]Interface{}
+	for n, m := range this.members {
+		result[n] = m.cluster
+	}
+	return result
+}
+
+// Restart removes the given member and recreates it after
+// DYNAMIC_CLUSTER_RESTART_DELAY. It is ignored if the cluster
+// is not an active member anymore.
+func (this *DynamicClusters) Restart(c Interface) {
+	this.lock.Lock()
+	member := this.members[c.GetName()]
+	found := member != nil && member.cluster == c
+	if found {
+		member.restart = true
+	}
+	this.lock.Unlock()
+
+	if found {
+		this.logger.Infof("restart of dynamic cluster %q requested", c.GetName())
+		this.queue.AddAfter(c.GetName(), DYNAMIC_CLUSTER_RESTART_DELAY)
+	}
+}
+
+func (this *DynamicClusters) update(obj resources.Object) {
+	secret, ok := obj.Data().(*corev1.Secret)
+	if !ok {
+		return
+	}
+	this.lock.Lock()
+	this.secrets[secret.Name] = secret
+	this.lock.Unlock()
+	this.queue.Add(secret.Name)
+}
+
+func (this *DynamicClusters) delete(name string) {
+	this.lock.Lock()
+	delete(this.secrets, name)
+	this.lock.Unlock()
+	this.queue.Add(name)
+}
+
+func (this *DynamicClusters) run() {
+	go func() {
+		<-this.ctx.Done()
+		this.queue.ShutDown()
+	}()
+	for {
+		key, shutdown := this.queue.Get()
+		if shutdown {
+			break
+		}
+		name := key.(string)
+		if err := this.handle(name); err != nil {
+			this.logger.Errorf("dynamic cluster %q: %s", name, err)
+			this.queue.AddRateLimited(key)
+		} else {
+			this.queue.Forget(key)
+		}
+		this.queue.Done(key)
+	}
+
+	this.lock.Lock()
+	names := make([]string, 0, len(this.members))
+	for n := range this.members {
+		names = append(names, n)
+	}
+	this.lock.Unlock()
+	sort.Strings(names)
+	for _, n := range names {
+		this.remove(n)
+	}
+}
+
+func (this *DynamicClusters) handle(name string) error {
+	this.lock.Lock()
+	secret := this.secrets[name]
+	member := this.members[name]
+	this.lock.Unlock()
+
+	var data []byte
+	if secret != nil {
+		data = secret.Data[this.spec.Key]
+		if len(data) == 0 {
+			this.logger.Warnf("secret %s/%s has no kubeconfig key %q -> ignored", secret.Namespace, name, this.spec.Key)
+		}
+	}
+	if len(data) == 0 {
+		if member != nil {
+			this.remove(name)
+		}
+		return nil
+	}
+
+	id := string(secret.Data[DYNAMIC_CLUSTER_ID_KEY])
+	sum := sha256.Sum256(append(append([]byte{}, data...), []byte("\n"+id)...))
+	hash := hex.EncodeToString(sum[:])
+	if member != nil {
+		switch {
+		case member.restart:
+			this.logger.Infof("restarting dynamic cluster %q", name)
+		case member.hash == hash:
+			return nil
+		default:
+			this.logger.Infof("kubeconfig for dynamic cluster %q changed -> recreate", name)
+		}
+		this.remove(name)
+	}
+	return this.add(name, id, hash, data)
+}
+
+func (this *DynamicClusters) add(name, id, hash string, data []byte) error {
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("secret for dynamic cluster %q has no cluster id (data key %q)", name, DYNAMIC_CLUSTER_ID_KEY)
+	}
+	kubeconfig, err := clientcmd.Load(data)
+	if err != nil {
+		return fmt.Errorf("invalid kubeconfig: %s", err)
+	}
+	if err := checkDynamicKubeconfig(kubeconfig); err != nil {
+		return fmt.Errorf("invalid kubeconfig: %s", err)
+	}
+	cfg, err := clientcmd.NewDefaultClientConfig(*kubeconfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("invalid kubeconfig: %s", err)
+	}
+	def := Configure(name, "", fmt.Sprintf("dynamic cluster %s", name)).Scheme(this.spec.Scheme).Definition()
+	ctx := ctxutil.CancelContext(this.ctx)
+	log := this.logger.NewContext("cluster", name)
+	c, err := CreateClusterForScheme(ctx, log, def, id, cfg, this.spec.Scheme)
+	if err != nil {
+		ctxutil.Cancel(ctx)
+		return err
+	}
+
+	this.logger.Infof("adding dynamic cluster %q[%s](%s)", c.GetName(), c.GetId(), c.GetServerVersion().Original())
+	if err := this.handler.ClusterAdded(c); err != nil {
+		this.handler.ClusterRemoved(c)
+		ctxutil.Cancel(ctx)
+		return err
+	}
+	this.lock.Lock()
+	this.members[name] = &dynamicMember{cluster: c, ctx: ctx, hash: hash}
+	this.lock.Unlock()
+	return nil
+}
+
+func (this *DynamicClusters) remove(name string) {
+	this.lock.Lock()
+	member := this.members[name]
+	delete(this.members, name)
+	this.lock.Unlock()
+
+	if member != nil {
+		this.logger.Infof("removing dynamic cluster %q[%s]", name, member.cluster.GetId())
+		this.handler.ClusterRemoved(member.cluster)
+		ctxutil.Cancel(member.ctx)
+	}
+}
+
+// checkDynamicKubeconfig rejects kubeconfigs of dynamic clusters using
+// credential plugins or references to local files. Such kubeconfigs are
+// read from secrets, which must not be able to execute commands or read
+// files of the controller manager. Only inline data is accepted.
+func checkDynamicKubeconfig(cfg *clientcmdapi.Config) error {
+	for n, c := range cfg.Clusters {
+		if c.CertificateAuthority != "" {
+			return fmt.Errorf("cluster %q: certificate-authority file not allowed, use certificate-authority-data", n)
+		}
+	}
+	for n, a := range cfg.AuthInfos {
+		switch {
+		case a.Exec != nil:
+			return fmt.Errorf("user %q: exec credential plugin not allowed", n)
+		case a.AuthProvider != nil:
+			return fmt.Errorf("user %q: auth provider not allowed", n)
+		case a.ClientCertificate != "":
+			return fmt.Errorf("user %q: client-certificate file not allowed, use client-certificate-data", n)
+		case a.ClientKey != "":
+			return fmt.Errorf("user %q: client-key file not allowed, use client-key-data", n)
+		case a.TokenFile != "":
+			return fmt.Errorf("user %q: tokenFile not allowed, use token", n)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// newDiscoveryServer serves the discovery endpoints of a
+// kube-apiserver providing the core group only.
+func newDiscoveryServer() *httptest.Server {
+	docs := map[string]interface{}{
+		"/version": map[string]string{"gitVersion": "v1.34.1"},
+		"/api":     metav1.APIVersions{Versions: []string{"v1"}},
+		"/apis":    metav1.APIGroupList{},
+		"/api/v1": metav1.APIResourceList{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: metav1.Verbs{"get", "list", "watch"}},
+				{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: metav1.Verbs{"get", "list", "watch"}},
+			},
+		},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		doc, ok := docs[req.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(doc)
+	}))
+}
+
+func kubeconfigFor(server string) []byte {
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: secret
+`, server))
+}
+
+type dynamicHandler struct {
+	lock    sync.Mutex
+	fail    error
+	added   []Interface
+	removed []Interface
+}
+
+func (this *dynamicHandler) ClusterAdded(c Interface) error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.added = append(this.added, c)
+	return this.fail
+}
+
+func (this *dynamicHandler) ClusterRemoved(c Interface) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.removed = append(this.removed, c)
+}
+
+var _ = Describe("dynamic clusters", func() {
+	var (
+		ctx     context.Context
+		cancel  context.CancelFunc
+		server  *httptest.Server
+		scheme  *runtime.Scheme
+		handler *dynamicHandler
+		dyn     *DynamicClusters
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		server = newDiscoveryServer()
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		handler = &dynamicHandler{}
+		dyn = NewDynamicClusters(ctx, logger.New(), nil, DynamicClusterSpec{Namespace: "default", Scheme: scheme}, handler)
+	})
+
+	AfterEach(func() {
+		dyn.queue.ShutDown()
+		cancel()
+		server.Close()
+	})
+
+	setSecret := func(name string, data map[string][]byte) {
+		dyn.lock.Lock()
+		defer dyn.lock.Unlock()
+		dyn.secrets[name] = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}, Data: data}
+	}
+
+	It("creates clusters for a definition with a scheme", func() {
+		def := Configure("test", "", "test cluster").Scheme(scheme).Definition()
+		c, err := CreateClusterForScheme(ctx, logger.New(), def, "test-id", &restclient.Config{Host: server.URL}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.ResourceContext().Scheme()).To(BeIdenticalTo(scheme))
+		Expect(c.GetServerVersion().Original()).To(Equal("v1.34.1"))
+	})
+
+	It("creates a member for a kubeconfig secret", func() {
+		setSecret("member", map[string][]byte{
+			DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL),
+			DYNAMIC_CLUSTER_ID_KEY:         []byte("member-id"),
+		})
+		Expect(dyn.handle("member")).To(Succeed())
+
+		Expect(handler.added).To(HaveLen(1))
+		c := handler.added[0]
+		Expect(c.GetName()).To(Equal("member"))
+		Expect(c.GetId()).To(Equal("member-id"))
+		Expect(c.ResourceContext().Scheme()).To(BeIdenticalTo(scheme))
+		Expect(dyn.Members()).To(HaveKeyWithValue("member", c))
+
+		// unchanged secrets keep the member
+		Expect(dyn.handle("member")).To(Succeed())
+		Expect(handler.added).To(HaveLen(1))
+		Expect(handler.removed).To(BeEmpty())
+	})
+
+	It("recreates a member for a changed kubeconfig", func() {
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL), DYNAMIC_CLUSTER_ID_KEY: []byte("a")})
+		Expect(dyn.handle("member")).To(Succeed())
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL), DYNAMIC_CLUSTER_ID_KEY: []byte("b")})
+		Expect(dyn.handle("member")).To(Succeed())
+
+		Expect(handler.added).To(HaveLen(2))
+		Expect(handler.removed).To(Equal([]Interface{handler.added[0]}))
+		Expect(dyn.Members()["member"].GetId()).To(Equal("b"))
+	})
+
+	It("removes a member for a deleted secret", func() {
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL), DYNAMIC_CLUSTER_ID_KEY: []byte("a")})
+		Expect(dyn.handle("member")).To(Succeed())
+		c := handler.added[0]
+
+		dyn.delete("member")
+		Expect(dyn.handle("member")).To(Succeed())
+		Expect(handler.removed).To(Equal([]Interface{c}))
+		Expect(dyn.Members()).To(BeEmpty())
+		Expect(dyn.members).To(BeEmpty())
+	})
+
+	It("drops a member rejected by the handler", func() {
+		handler.fail = fmt.Errorf("rejected")
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL), DYNAMIC_CLUSTER_ID_KEY: []byte("a")})
+		Expect(dyn.handle("member")).To(MatchError("rejected"))
+
+		Expect(handler.removed).To(Equal(handler.added))
+		Expect(dyn.Members()).To(BeEmpty())
+	})
+
+	It("ignores secrets without kubeconfig", func() {
+		setSecret("member", map[string][]byte{"other": []byte("data")})
+		Expect(dyn.handle("member")).To(Succeed())
+		Expect(handler.added).To(BeEmpty())
+	})
+
+	It("rejects invalid kubeconfigs", func() {
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: []byte("invalid"), DYNAMIC_CLUSTER_ID_KEY: []byte("a")})
+		Expect(dyn.handle("member")).To(MatchError(ContainSubstring("invalid kubeconfig")))
+		Expect(handler.added).To(BeEmpty())
+	})
+
+	It("rejects secrets without cluster id", func() {
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL)})
+		Expect(dyn.handle("member")).To(MatchError(ContainSubstring("no cluster id")))
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL), DYNAMIC_CLUSTER_ID_KEY: []byte(" ")})
+		Expect(dyn.handle("member")).To(MatchError(ContainSubstring("no cluster id")))
+		Expect(handler.added).To(BeEmpty())
+	})
+
+	DescribeTable("rejects kubeconfigs with plugins or file references",
+		func(old, new, msg string) {
+			data := strings.Replace(string(kubeconfigFor(server.URL)), old, new, 1)
+			setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: []byte(data), DYNAMIC_CLUSTER_ID_KEY: []byte("a")})
+			Expect(dyn.handle("member")).To(MatchError(And(ContainSubstring("invalid kubeconfig"), ContainSubstring(msg))))
+			Expect(handler.added).To(BeEmpty())
+		},
+		Entry("exec plugin", "    token: secret\n", "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: /bin/sh\n", "exec credential plugin"),
+		Entry("auth provider", "    token: secret\n", "    auth-provider:\n      name: oidc\n", "auth provider"),
+		Entry("token file", "    token: secret\n", "    tokenFile: /var/run/secrets/token\n", "tokenFile"),
+		Entry("client certificate", "    token: secret\n", "    client-certificate: /etc/cert.pem\n", "client-certificate"),
+		Entry("client key", "    token: secret\n", "    client-key: /etc/key.pem\n", "client-key"),
+		Entry("certificate authority", "    server: ", "    certificate-authority: /etc/ca.pem\n    server: ", "certificate-authority"),
+	)
+
+	It("recreates a member on restart", func() {
+		setSecret("member", map[string][]byte{DYNAMIC_CLUSTER_KUBECONFIG_KEY: kubeconfigFor(server.URL), DYNAMIC_CLUSTER_ID_KEY: []byte("a")})
+		Expect(dyn.handle("member")).To(Succeed())
+		old := handler.added[0]
+		oldCtx := dyn.members["member"].ctx
+
+		dyn.Restart(old)
+		Expect(dyn.members["member"].restart).To(BeTrue())
+		Expect(dyn.handle("member")).To(Succeed())
+
+		Expect(handler.removed).To(Equal([]Interface{old}))
+		Expect(handler.added).To(HaveLen(2))
+		Expect(oldCtx.Err()).To(HaveOccurred())
+		Expect(dyn.members["member"].restart).To(BeFalse())
+		Expect(dyn.Members()["member"]).To(BeIdenticalTo(handler.added[1]))
+
+		// restarts for replaced members are ignored
+		dyn.Restart(old)
+		Expect(dyn.members["member"].restart).To(BeFalse())
+	})
+})
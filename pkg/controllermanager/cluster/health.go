@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/discovery"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
+)
+
+// connectionCheckInterval is the minimal duration between two
+// connection checks for a cluster. Probes in between
+// report the last result.
+const connectionCheckInterval = 10 * time.Second
+
+// RegisterReadinessChecks registers the readiness checks for the
+// connection to the API server of a cluster (cluster/<name>) and the
+// sync state of its informers (informers/<name>). The sync state is
+// additionally registered as startup check. The health check
+// informers/<name> fails if a watched resource has vanished.
+func RegisterReadinessChecks(c Interface) error {
+	cfg := c.Config()
+	cfg.Timeout = 5 * time.Second
+	client, err := discovery.NewDiscoveryClientForConfig(&cfg)
+	if err != nil {
+		return err
+	}
+	informers := healthz.CheckerFunc(func() healthz.Status {
+		return informerStatus(c)
+	})
+	ready.RegisterCheck("cluster/"+c.GetName(), &connectionCheck{client: client})
+	ready.RegisterCheck("informers/"+c.GetName(), informers)
+	ready.RegisterStartupCheck("informers/"+c.GetName(), informers)
+	healthz.Register("informers/"+c.GetName(), healthz.CheckerFunc(func() healthz.Status {
+		return lostInformerStatus(c)
+	}))
+	return nil
+}
+
+type connectionCheck struct {
+	lock   sync.Mutex
+	client discovery.ServerVersionInterface
+	last   time.Time
+	status healthz.Status
+}
+
+func (this *connectionCheck) Check() healthz.Status {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if time.Since(this.last) < connectionCheckInterval {
+		return this.status
+	}
+	v, err := this.client.ServerVersion()
+	if err != nil {
+		this.status = healthz.NewStatus("", fmt.Sprintf("cannot connect to API server: %s", err))
+	} else {
+		this.status = healthz.Status{Healthy: true, Reason: "API server version " + v.GitVersion}
+	}
+	this.last = time.Now()
+	return this.status
+}
+
+// informerStatus reports the sync state of the informers of a cluster.
+// Informers not synced within the cache sync timeout are reported with
+// their last error and are counted as not synced. Informers, whose watched
+// resource has vanished, fail the check, also.
+func informerStatus(c Interface) healthz.Status {
+	status := healthz.NewStatus("", "")
+	pending := 0
+	timedOut := 0
+	lost := 0
+	list := c.ResourceContext().SharedInformerFactory().InformerStatus()
+	for _, i := range list {
+		name := informerName(i)
+		switch {
+		case !i.Started:
+			status.Components = append(status.Components, healthz.Status{Name: name, Healthy: true, Reason: "not started"})
+		case i.TimedOut:
+			pending++
+			timedOut++
+			status.Components = append(status.Components, healthz.NewStatus(name, syncReason("cache sync timed out", i.Error)))
+		case !i.Synced:
+			pending++
+			status.Components = append(status.Components, healthz.NewStatus(name, syncReason("not synced", i.Error)))
+		case i.Lost:
+			lost++
+			status.Components = append(status.Components, healthz.NewStatus(name, syncReason("resource lost", i.Error)))
+		default:
+			status.Components = append(status.Components, healthz.NewStatus(name, ""))
+		}
+	}
+	var reasons []string
+	if pending > 0 {
+		reason := fmt.Sprintf("%d of %d informers not synced", pending, len(list))
+		if timedOut > 0 {
+			reason += fmt.Sprintf(" (%d timed out)", timedOut)
+		}
+		reasons = append(reasons, reason)
+	}
+	if lost > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d of %d informers lost their resource", lost, len(list)))
+	}
+	if len(reasons) > 0 {
+		status.Healthy = false
+		status.Reason = strings.Join(reasons, ", ")
+	}
+	return status
+}
+
+// lostInformerStatus reports the informers of a cluster, whose
+// watched resource has vanished after the cache has been synced.
+func lostInformerStatus(c Interface) healthz.Status {
+	status := healthz.NewStatus("", "")
+	lost := 0
+	for _, i := range c.ResourceContext().SharedInformerFactory().InformerStatus() {
+		if i.Lost {
+			lost++
+			status.Components = append(status.Components, healthz.NewStatus(informerName(i), syncReason("resource lost", i.Error)))
+		}
+	}
+	if lost > 0 {
+		status.Healthy = false
+		status.Reason = fmt.Sprintf("watched resource of %d informers vanished", lost)
+	}
+	return status
+}
+
+func informerName(i resources.InformerStatus) string {
+	name := i.GroupVersionKind.String()
+	if i.Namespace != "" {
+		name += " (namespace " + i.Namespace + ")"
+	}
+	return name
+}
+
+func syncReason(reason string, err string) string {
+	if err != "" {
+		return reason + ": " + err
+	}
+	return reason
+}
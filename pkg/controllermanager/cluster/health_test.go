@@ -0,0 +1,114 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/resources"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
+)
+
+type testInformerCluster struct {
+	Interface
+	rctx *testInformerContext
+}
+
+func (this *testInformerCluster) ResourceContext() resources.ResourceContext {
+	return this.rctx
+}
+
+type testInformerContext struct {
+	resources.ResourceContext
+	factory *testInformerFactory
+}
+
+func (this *testInformerContext) SharedInformerFactory() resources.SharedInformerFactory {
+	return this.factory
+}
+
+type testInformerFactory struct {
+	resources.SharedInformerFactory
+	status []resources.InformerStatus
+}
+
+func (this *testInformerFactory) InformerStatus() []resources.InformerStatus {
+	return this.status
+}
+
+var _ = Describe("informer health", func() {
+	secrets := schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+	deployments := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	crds := schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Example"}
+
+	var factory *testInformerFactory
+	var c Interface
+
+	BeforeEach(func() {
+		factory = &testInformerFactory{}
+		c = &testInformerCluster{rctx: &testInformerContext{factory: factory}}
+	})
+
+	It("is ready if all started informers are synced", func() {
+		factory.status = []resources.InformerStatus{
+			{GroupVersionKind: deployments, Started: true, Synced: true},
+			{GroupVersionKind: secrets, Namespace: "default"},
+		}
+		Expect(informerStatus(c)).To(Equal(healthz.Status{
+			Healthy: true,
+			Components: []healthz.Status{
+				{Name: "apps/v1, Kind=Deployment", Healthy: true},
+				{Name: "/v1, Kind=Secret (namespace default)", Healthy: true, Reason: "not started"},
+			},
+		}))
+		Expect(lostInformerStatus(c).Healthy).To(BeTrue())
+	})
+
+	It("counts timed out informers as not synced", func() {
+		factory.status = []resources.InformerStatus{
+			{GroupVersionKind: deployments, Started: true, TimedOut: true, Error: "deployments.apps is forbidden"},
+			{GroupVersionKind: secrets, Started: true},
+			{GroupVersionKind: crds, Started: true, Synced: true},
+		}
+		Expect(informerStatus(c)).To(Equal(healthz.Status{
+			Healthy: false,
+			Reason:  "2 of 3 informers not synced (1 timed out)",
+			Components: []healthz.Status{
+				{Name: "apps/v1, Kind=Deployment", Reason: "cache sync timed out: deployments.apps is forbidden"},
+				{Name: "/v1, Kind=Secret", Reason: "not synced"},
+				{Name: "example.org/v1, Kind=Example", Healthy: true},
+			},
+		}))
+		Expect(lostInformerStatus(c).Healthy).To(BeTrue())
+	})
+
+	It("fails readiness and liveness for lost resources", func() {
+		lost := "failed to list example.org/v1, Kind=Example: the server could not find the requested resource"
+		factory.status = []resources.InformerStatus{
+			{GroupVersionKind: crds, Started: true, Synced: true, Lost: true, Error: lost},
+			{GroupVersionKind: secrets, Started: true, Synced: true},
+		}
+		Expect(informerStatus(c)).To(Equal(healthz.Status{
+			Healthy: false,
+			Reason:  "1 of 2 informers lost their resource",
+			Components: []healthz.Status{
+				{Name: "example.org/v1, Kind=Example", Reason: "resource lost: " + lost},
+				{Name: "/v1, Kind=Secret", Healthy: true},
+			},
+		}))
+		Expect(lostInformerStatus(c)).To(Equal(healthz.Status{
+			Healthy: false,
+			Reason:  "watched resource of 1 informers vanished",
+			Components: []healthz.Status{
+				{Name: "example.org/v1, Kind=Example", Reason: "resource lost: " + lost},
+			},
+		}))
+	})
+})
@@ -0,0 +1,324 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package cluster
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/utils"
+)
+
+// minReauthInterval limits the forced reloads of the
+// credentials triggered by unauthorized responses.
+const minReauthInterval = 10 * time.Second
+
+// reloadDelay is used to collect multiple file events
+// (for example for secret volume updates) into a single reload.
+const reloadDelay = 500 * time.Millisecond
+
+type configBuilder func() (*restclient.Config, error)
+
+// credentialsReloader is a round tripper used for all REST clients of a
+// cluster. It delegates to a transport built from the actual content of the
+// kubeconfig file, which is rebuilt whenever the kubeconfig or a file
+// referenced by it (token, certificates) changes, or a request is rejected
+// as unauthorized. On a change running watch requests are closed, so that
+// the informers reestablish their watches with the new credentials.
+type credentialsReloader struct {
+	lock    sync.RWMutex
+	logger  logger.LogContext
+	name    string
+	path    string
+	build   configBuilder
+	config  *restclient.Config
+	current http.RoundTripper
+	files   utils.StringSet
+	watcher *fsnotify.Watcher
+
+	reauth  time.Time
+	pending *time.Timer
+
+	wlock   sync.Mutex
+	watches map[*watchBody]struct{}
+}
+
+var _ http.RoundTripper = &credentialsReloader{}
+
+func newCredentialsReloader(ctx context.Context, logger logger.LogContext, name string, path string, build configBuilder) (*credentialsReloader, error) {
+	this := &credentialsReloader{
+		logger:  logger,
+		name:    name,
+		path:    path,
+		build:   build,
+		files:   utils.StringSet{},
+		watches: map[*watchBody]struct{}{},
+	}
+	if err := this.reload(); err != nil {
+		return nil, err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	this.watcher = watcher
+	this.updateWatches()
+	go this.watch()
+	go func() {
+		<-ctx.Done()
+		_ = this.watcher.Close()
+	}()
+	return this, nil
+}
+
+// Config returns the rest config to be used for the cluster. It keeps the
+// client settings of the actual config, but delegates transport and
+// authentication to the reloader.
+func (this *credentialsReloader) Config() *restclient.Config {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	cfg := restclient.CopyConfig(this.config)
+	cfg.TLSClientConfig = restclient.TLSClientConfig{}
+	cfg.Username = ""
+	cfg.Password = ""
+	cfg.BearerToken = ""
+	cfg.BearerTokenFile = ""
+	cfg.Impersonate = restclient.ImpersonationConfig{}
+	cfg.AuthProvider = nil
+	cfg.AuthConfigPersister = nil
+	cfg.ExecProvider = nil
+	cfg.WrapTransport = nil
+	cfg.Dial = nil
+	cfg.Proxy = nil
+	cfg.Transport = this
+	return cfg
+}
+
+func (this *credentialsReloader) RoundTrip(req *http.Request) (*http.Response, error) {
+	this.lock.RLock()
+	rt := this.current
+	this.lock.RUnlock()
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return this.track(req, resp), err
+	}
+
+	if !this.forceReload() {
+		return resp, err
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return resp, err
+	}
+	this.lock.RLock()
+	next := this.current
+	this.lock.RUnlock()
+	if next == rt {
+		return resp, err
+	}
+
+	// retry once with the new credentials
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, berr := req.GetBody()
+		if berr != nil {
+			return resp, err
+		}
+		retry.Body = body
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	resp, err = next.RoundTrip(retry)
+	return this.track(req, resp), err
+}
+
+// forceReload reloads the credentials after an unauthorized response,
+// if not already done recently.
+func (this *credentialsReloader) forceReload() bool {
+	this.lock.Lock()
+	if time.Since(this.reauth) < minReauthInterval {
+		this.lock.Unlock()
+		return false
+	}
+	this.reauth = time.Now()
+	this.lock.Unlock()
+
+	this.logger.Infof("unauthorized request for cluster %q -> reloading credentials", this.name)
+	if err := this.reload(); err != nil {
+		this.logger.Errorf("cannot reload kubeconfig %q for cluster %q: %s", this.path, this.name, err)
+		return false
+	}
+	return true
+}
+
+// reload builds a new transport for the actual content of the kubeconfig.
+func (this *credentialsReloader) reload() error {
+	cfg, err := this.build()
+	if err != nil {
+		return err
+	}
+	rt, err := restclient.TransportFor(cfg)
+	if err != nil {
+		return err
+	}
+
+	this.lock.Lock()
+	old := this.current
+	if this.config != nil && this.config.Host != cfg.Host {
+		this.logger.Warnf("server for cluster %q changed from %q to %q: restart required", this.name, this.config.Host, cfg.Host)
+	}
+	this.config = cfg
+	this.current = rt
+	this.lock.Unlock()
+
+	if old != nil {
+		this.logger.Infof("credentials for cluster %q reloaded", this.name)
+		this.closeWatches()
+		utilnet.CloseIdleConnectionsFor(old)
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// file watch
+
+// updateWatches watches the kubeconfig and the files referenced by it.
+// Files are watched by their directory, because secret volumes
+// replace the files by switching a symbolic link.
+func (this *credentialsReloader) updateWatches() {
+	this.lock.RLock()
+	files := utils.NewStringSet(this.path)
+	for _, f := range []string{this.config.BearerTokenFile, this.config.CertFile, this.config.KeyFile, this.config.CAFile} {
+		if f != "" {
+			files.Add(f)
+		}
+	}
+	this.lock.RUnlock()
+
+	for f := range files {
+		this.lock.RLock()
+		found := this.files.Contains(f)
+		this.lock.RUnlock()
+		if found {
+			continue
+		}
+		if err := this.watcher.Add(filepath.Dir(f)); err != nil {
+			this.logger.Warnf("cannot watch %q for cluster %q: %s", f, this.name, err)
+			continue
+		}
+		this.lock.Lock()
+		this.files.Add(f)
+		this.lock.Unlock()
+	}
+}
+
+func (this *credentialsReloader) relevant(name string) bool {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	for f := range this.files {
+		if filepath.Clean(name) == filepath.Clean(f) || filepath.Base(name) == "..data" && filepath.Dir(name) == filepath.Dir(f) {
+			return true
+		}
+	}
+	return false
+}
+
+func (this *credentialsReloader) watch() {
+	for {
+		select {
+		case event, ok := <-this.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) != 0 && this.relevant(event.Name) {
+				this.scheduleReload()
+			}
+		case err, ok := <-this.watcher.Errors:
+			if !ok {
+				return
+			}
+			this.logger.Errorf("kubeconfig watch error for cluster %q: %s", this.name, err)
+		}
+	}
+}
+
+func (this *credentialsReloader) scheduleReload() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if this.pending != nil {
+		return
+	}
+	this.pending = time.AfterFunc(reloadDelay, func() {
+		this.lock.Lock()
+		this.pending = nil
+		this.lock.Unlock()
+		this.logger.Infof("kubeconfig for cluster %q changed", this.name)
+		if err := this.reload(); err != nil {
+			this.logger.Errorf("cannot reload kubeconfig %q for cluster %q: %s", this.path, this.name, err)
+			return
+		}
+		this.updateWatches()
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// running watches
+
+type watchBody struct {
+	io.ReadCloser
+	owner *credentialsReloader
+	once  sync.Once
+}
+
+func (this *watchBody) Close() error {
+	this.once.Do(func() {
+		this.owner.wlock.Lock()
+		delete(this.owner.watches, this)
+		this.owner.wlock.Unlock()
+	})
+	return this.ReadCloser.Close()
+}
+
+func (this *credentialsReloader) track(req *http.Request, resp *http.Response) *http.Response {
+	if resp == nil || resp.StatusCode != http.StatusOK || req.URL.Query().Get("watch") != "true" {
+		return resp
+	}
+	body := &watchBody{ReadCloser: resp.Body, owner: this}
+	this.wlock.Lock()
+	this.watches[body] = struct{}{}
+	this.wlock.Unlock()
+	resp.Body = body
+	return resp
+}
+
+// closeWatches closes all running watch requests to force the
+// informers to reestablish their watches with the new credentials.
+func (this *credentialsReloader) closeWatches() {
+	this.wlock.Lock()
+	bodies := make([]*watchBody, 0, len(this.watches))
+	for b := range this.watches {
+		bodies = append(bodies, b)
+	}
+	this.wlock.Unlock()
+
+	if len(bodies) > 0 {
+		this.logger.Infof("restarting %d watch(es) for cluster %q", len(bodies), this.name)
+	}
+	for _, b := range bodies {
+		_ = b.Close()
+	}
+}
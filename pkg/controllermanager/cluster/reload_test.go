@@ -0,0 +1,275 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package cluster
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	restclient "k8s.io/client-go/rest"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/utils"
+)
+
+// tokenServer accepts requests authorized with the actually valid token.
+// Watch requests are streamed until the client closes them.
+type tokenServer struct {
+	*httptest.Server
+	lock   sync.Mutex
+	valid  string
+	bodies []string
+}
+
+func newTokenServer(valid string) *tokenServer {
+	this := &tokenServer{valid: valid}
+	this.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		this.lock.Lock()
+		valid := this.valid
+		if req.Body != nil {
+			data, _ := io.ReadAll(req.Body)
+			this.bodies = append(this.bodies, string(data))
+		}
+		this.lock.Unlock()
+
+		if req.Header.Get("Authorization") != "Bearer "+valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if req.URL.Query().Get("watch") != "true" {
+			_, _ = w.Write([]byte("ok"))
+			return
+		}
+		_, _ = w.Write([]byte("event\n"))
+		w.(http.Flusher).Flush()
+		<-req.Context().Done()
+	}))
+	return this
+}
+
+func (this *tokenServer) setValid(token string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.valid = token
+}
+
+func (this *tokenServer) requestBodies() []string {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return append([]string(nil), this.bodies...)
+}
+
+var _ = Describe("credentials reloader", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		server *tokenServer
+		dir    string
+		path   string
+
+		lock   sync.Mutex
+		token  string
+		builds int
+	)
+
+	build := func() (*restclient.Config, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		builds++
+		return &restclient.Config{Host: server.URL, BearerToken: token}, nil
+	}
+	setToken := func(t string) {
+		lock.Lock()
+		defer lock.Unlock()
+		token = t
+	}
+	buildCount := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return builds
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		server = newTokenServer("old")
+		dir = GinkgoT().TempDir()
+		path = filepath.Join(dir, "kubeconfig")
+		Expect(os.WriteFile(path, []byte("initial"), 0o600)).To(Succeed())
+		token = "old"
+		builds = 0
+	})
+
+	AfterEach(func() {
+		cancel()
+		server.Close()
+	})
+
+	newReloader := func() *credentialsReloader {
+		r, err := newCredentialsReloader(ctx, logger.New(), "test", path, build)
+		Expect(err).NotTo(HaveOccurred())
+		return r
+	}
+
+	running := func(r *credentialsReloader) int {
+		r.wlock.Lock()
+		defer r.wlock.Unlock()
+		return len(r.watches)
+	}
+
+	get := func(r *credentialsReloader, query string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/api"+query, nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := r.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		return resp
+	}
+
+	Context("relevant", func() {
+		It("matches the watched files and secret volume updates in their directories", func() {
+			r := &credentialsReloader{files: utils.NewStringSet("/etc/kube/kubeconfig", "/var/token/token")}
+
+			Expect(r.relevant("/etc/kube/kubeconfig")).To(BeTrue())
+			Expect(r.relevant("/etc/kube/./kubeconfig")).To(BeTrue())
+			Expect(r.relevant("/etc/kube/..data")).To(BeTrue())
+			Expect(r.relevant("/var/token/..data")).To(BeTrue())
+			Expect(r.relevant("/etc/kube/other")).To(BeFalse())
+			Expect(r.relevant("/etc/other/..data")).To(BeFalse())
+			Expect(r.relevant("/var/token/token.bak")).To(BeFalse())
+		})
+
+		It("watches the kubeconfig and the referenced files", func() {
+			tokenFile := filepath.Join(dir, "token")
+			Expect(os.WriteFile(tokenFile, []byte("old"), 0o600)).To(Succeed())
+			r, err := newCredentialsReloader(ctx, logger.New(), "test", path, func() (*restclient.Config, error) {
+				return &restclient.Config{Host: server.URL, BearerTokenFile: tokenFile}, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(r.relevant(path)).To(BeTrue())
+			Expect(r.relevant(tokenFile)).To(BeTrue())
+		})
+	})
+
+	Context("config", func() {
+		It("delegates authentication to the reloader", func() {
+			r := newReloader()
+			cfg := r.Config()
+
+			Expect(cfg.Host).To(Equal(server.URL))
+			Expect(cfg.BearerToken).To(BeEmpty())
+			Expect(cfg.Transport).To(BeIdenticalTo(r))
+		})
+	})
+
+	Context("running watches", func() {
+		It("tracks successful watch requests only", func() {
+			r := newReloader()
+
+			resp := get(r, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(running(r)).To(BeZero())
+
+			resp = get(r, "?watch=true")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(running(r)).To(Equal(1))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(running(r)).To(BeZero())
+		})
+
+		It("closes running watches on reload", func() {
+			r := newReloader()
+
+			resp := get(r, "?watch=true")
+			line := make([]byte, 6)
+			_, err := io.ReadFull(resp.Body, line)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(line)).To(Equal("event\n"))
+
+			Expect(r.reload()).To(Succeed())
+			Expect(running(r)).To(BeZero())
+			_, err = resp.Body.Read(line)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("reloads on changes of the kubeconfig file", func() {
+			r := newReloader()
+			Expect(buildCount()).To(Equal(1))
+
+			resp := get(r, "?watch=true")
+			Expect(running(r)).To(Equal(1))
+
+			Expect(os.WriteFile(path, []byte("changed"), 0o600)).To(Succeed())
+			Eventually(buildCount, 5*time.Second, 50*time.Millisecond).Should(Equal(2))
+			Eventually(func() int { return running(r) }).Should(BeZero())
+			_ = resp.Body.Close()
+		})
+	})
+
+	Context("unauthorized requests", func() {
+		It("rebuilds the transport and retries the request once", func() {
+			r := newReloader()
+			server.setValid("new")
+			setToken("new")
+
+			resp := get(r, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(buildCount()).To(Equal(2))
+		})
+
+		It("retries requests with a replayable body", func() {
+			r := newReloader()
+			server.setValid("new")
+			setToken("new")
+
+			req, err := http.NewRequest(http.MethodPost, server.URL+"/api", strings.NewReader("payload"))
+			Expect(err).NotTo(HaveOccurred())
+			resp, err := r.RoundTrip(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(server.requestBodies()).To(Equal([]string{"payload", "payload"}))
+		})
+
+		It("returns the unauthorized response if the reloaded credentials are rejected, too", func() {
+			r := newReloader()
+			server.setValid("new")
+
+			resp := get(r, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(buildCount()).To(Equal(2))
+		})
+
+		It("limits forced reloads", func() {
+			r := newReloader()
+			server.setValid("new")
+
+			resp := get(r, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(buildCount()).To(Equal(2))
+
+			setToken("new")
+			resp = get(r, "")
+			Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(buildCount()).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,204 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// SUBOPTION_CONTEXT is an option to select the context of the kubeconfig used for the cluster.
+const SUBOPTION_CONTEXT = "context"
+
+// SUBOPTION_IMPERSONATE_USER is an option to impersonate a user for all requests to the cluster.
+const SUBOPTION_IMPERSONATE_USER = "impersonate-user"
+
+// SUBOPTION_IMPERSONATE_GROUPS is an option to impersonate groups for all requests to the cluster.
+const SUBOPTION_IMPERSONATE_GROUPS = "impersonate-groups"
+
+// SUBOPTION_TIMEOUT is an option to set the request timeout for the cluster.
+const SUBOPTION_TIMEOUT = "timeout"
+
+// SUBOPTION_USER_AGENT is an option to set the user agent used for requests to the cluster.
+const SUBOPTION_USER_AGENT = "user-agent"
+
+func init() {
+	RegisterExtension(&KubeConfigContext{})
+	RegisterExtension(&Impersonation{})
+	RegisterExtension(&RequestTimeout{})
+	RegisterExtension(&UserAgent{})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// KubeConfigContext selects a dedicated context of a kubeconfig
+// instead of its current context. The context is selected when the
+// kubeconfig is loaded, before the rest config extensions are called.
+type KubeConfigContext struct{}
+
+var _ Extension = &KubeConfigContext{}
+
+func (this *KubeConfigContext) ExtendConfig(def Definition, cfg *Config) {
+	cfg.AddStringOption(nil, SUBOPTION_CONTEXT, "", "", fmt.Sprintf("kubeconfig context used for cluster %s", def.Name()))
+}
+
+func (this *KubeConfigContext) Extend(_ Interface, _ *Config) error {
+	return nil
+}
+
+// loadKubeConfig loads the rest config for a kubeconfig file
+// using the context selected for the cluster, if configured.
+func loadKubeConfig(def Definition, cfg *Config, kubeconfig string) (*restclient.Config, error) {
+	context := ""
+	if opt := cfg.GetOption(SUBOPTION_CONTEXT); opt != nil {
+		context = opt.StringValue()
+	}
+	if context == "" {
+		return clientcmd.BuildConfigFromFlags("", kubeconfig)
+	}
+	if kubeconfig == "" {
+		return nil, fmt.Errorf("context %q for cluster %s requires a kubeconfig file", context, def.Name())
+	}
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		&clientcmd.ConfigOverrides{CurrentContext: context})
+	restcfg, err := loader.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("cannot use context %q for cluster %s: %s", context, def.Name(), err)
+	}
+	return restcfg, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Impersonation impersonates a user and/or groups for all requests.
+type Impersonation struct{}
+
+var _ Extension = &Impersonation{}
+var _ RestConfigExtension = &Impersonation{}
+
+func (this *Impersonation) ExtendConfig(def Definition, cfg *Config) {
+	cfg.AddStringOption(nil, SUBOPTION_IMPERSONATE_USER, "", "", fmt.Sprintf("user to impersonate for cluster %s", def.Name()))
+	cfg.AddStringArrayOption(nil, SUBOPTION_IMPERSONATE_GROUPS, "", nil, fmt.Sprintf("groups to impersonate for cluster %s", def.Name()))
+}
+
+func (this *Impersonation) Extend(_ Interface, _ *Config) error {
+	return nil
+}
+
+func (this *Impersonation) TweakRestConfig(def Definition, cfg *Config, restcfg *restclient.Config) error {
+	user := ""
+	if opt := cfg.GetOption(SUBOPTION_IMPERSONATE_USER); opt != nil {
+		user = opt.StringValue()
+	}
+	var groups []string
+	if opt := cfg.GetOption(SUBOPTION_IMPERSONATE_GROUPS); opt != nil {
+		groups = opt.StringArray()
+	}
+	if len(groups) > 0 && user == "" {
+		return fmt.Errorf("impersonated groups for cluster %s require an impersonated user", def.Name())
+	}
+	if user != "" {
+		restcfg.Impersonate.UserName = user
+		restcfg.Impersonate.Groups = groups
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// RequestTimeout sets the timeout for requests. In contrast to the
+// timeout of the rest config it is not applied to watch requests,
+// which are kept open by the informers until the server closes them.
+type RequestTimeout struct{}
+
+var _ Extension = &RequestTimeout{}
+var _ RestConfigExtension = &RequestTimeout{}
+
+func (this *RequestTimeout) ExtendConfig(def Definition, cfg *Config) {
+	cfg.AddDurationOption(nil, SUBOPTION_TIMEOUT, "", 0, fmt.Sprintf("timeout for non-watch requests to cluster %s", def.Name()))
+}
+
+func (this *RequestTimeout) Extend(_ Interface, _ *Config) error {
+	return nil
+}
+
+func (this *RequestTimeout) TweakRestConfig(_ Definition, cfg *Config, restcfg *restclient.Config) error {
+	if opt := cfg.GetOption(SUBOPTION_TIMEOUT); opt != nil && opt.DurationValue() > 0 {
+		timeout := opt.DurationValue()
+		restcfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return &timeoutRoundTripper{delegate: rt, timeout: timeout}
+		})
+	}
+	return nil
+}
+
+// timeoutRoundTripper limits the duration of non-watch requests
+// including the read of the response body.
+type timeoutRoundTripper struct {
+	delegate http.RoundTripper
+	timeout  time.Duration
+}
+
+func (this *timeoutRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Query().Get("watch") == "true" {
+		return this.delegate.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), this.timeout)
+	resp, err := this.delegate.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (this *timeoutRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return this.delegate
+}
+
+// cancelBody releases the request context when the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (this *cancelBody) Close() error {
+	err := this.ReadCloser.Close()
+	this.cancel()
+	return err
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// UserAgent sets the user agent for requests.
+type UserAgent struct{}
+
+var _ Extension = &UserAgent{}
+var _ RestConfigExtension = &UserAgent{}
+
+func (this *UserAgent) ExtendConfig(def Definition, cfg *Config) {
+	cfg.AddStringOption(nil, SUBOPTION_USER_AGENT, "", "", fmt.Sprintf("user agent used for requests to cluster %s", def.Name()))
+}
+
+func (this *UserAgent) Extend(_ Interface, _ *Config) error {
+	return nil
+}
+
+func (this *UserAgent) TweakRestConfig(_ Definition, cfg *Config, restcfg *restclient.Config) error {
+	if opt := cfg.GetOption(SUBOPTION_USER_AGENT); opt != nil && opt.StringValue() != "" {
+		restcfg.UserAgent = opt.StringValue()
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package cluster
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	restclient "k8s.io/client-go/rest"
+)
+
+const multiContextKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: a
+  cluster:
+    server: https://a.example.org
+- name: b
+  cluster:
+    server: https://b.example.org
+contexts:
+- name: a
+  context:
+    cluster: a
+    user: a
+- name: b
+  context:
+    cluster: b
+    user: b
+current-context: a
+users:
+- name: a
+  user:
+    token: token-a
+- name: b
+  user:
+    token: token-b
+`
+
+var _ = Describe("rest config options", func() {
+	var (
+		def        Definition
+		kubeconfig string
+	)
+
+	BeforeEach(func() {
+		def = Configure("target", "target", "target cluster").Definition()
+		kubeconfig = filepath.Join(GinkgoT().TempDir(), "kubeconfig")
+		Expect(os.WriteFile(kubeconfig, []byte(multiContextKubeconfig), 0o600)).To(Succeed())
+	})
+
+	configFor := func(args ...string) *Config {
+		cfg := NewConfig(def)
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		cfg.AddToFlags(flags)
+		Expect(flags.Parse(args)).To(Succeed())
+		Expect(cfg.Evaluate()).To(Succeed())
+		return cfg
+	}
+
+	Context("context", func() {
+		It("uses the current context by default", func() {
+			restcfg, err := restConfig(def, configFor(), kubeconfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restcfg.Host).To(Equal("https://a.example.org"))
+			Expect(restcfg.BearerToken).To(Equal("token-a"))
+		})
+
+		It("selects the context before the config is tweaked", func() {
+			restcfg, err := restConfig(def, configFor(
+				"--target.context=b",
+				"--target.impersonate-user=user",
+				"--target.impersonate-groups=group",
+				"--target.user-agent=agent",
+				"--target.qps=42",
+			), kubeconfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restcfg.Host).To(Equal("https://b.example.org"))
+			Expect(restcfg.BearerToken).To(Equal("token-b"))
+			Expect(restcfg.Impersonate.UserName).To(Equal("user"))
+			Expect(restcfg.Impersonate.Groups).To(Equal([]string{"group"}))
+			Expect(restcfg.UserAgent).To(Equal("agent"))
+			Expect(restcfg.QPS).To(Equal(float32(42)))
+		})
+
+		It("rejects unknown contexts", func() {
+			_, err := restConfig(def, configFor("--target.context=c"), kubeconfig)
+			Expect(err).To(MatchError(ContainSubstring(`cannot use context "c" for cluster target`)))
+		})
+
+		It("requires a kubeconfig file", func() {
+			_, err := restConfig(def, configFor("--target.context=b"), "")
+			Expect(err).To(MatchError(ContainSubstring(`context "b" for cluster target requires a kubeconfig file`)))
+		})
+	})
+
+	Context("impersonation", func() {
+		It("requires a user for groups", func() {
+			_, err := restConfig(def, configFor("--target.impersonate-groups=group"), kubeconfig)
+			Expect(err).To(MatchError(ContainSubstring("require an impersonated user")))
+		})
+	})
+
+	Context("timeout", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+				time.Sleep(300 * time.Millisecond)
+				_, _ = w.Write([]byte("done"))
+			}))
+		})
+
+		AfterEach(func() {
+			server.CloseClientConnections()
+			server.Close()
+		})
+
+		get := func(restcfg *restclient.Config, query string) (string, error) {
+			rt, err := restclient.TransportFor(restcfg)
+			Expect(err).NotTo(HaveOccurred())
+			req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/secrets"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+			data, err := io.ReadAll(resp.Body)
+			return string(data), err
+		}
+
+		It("limits non-watch requests only", func() {
+			restcfg, err := restConfig(def, configFor("--target.timeout=100ms"), kubeconfig)
+			Expect(err).NotTo(HaveOccurred())
+			restcfg.Host = server.URL
+			Expect(restcfg.Timeout).To(BeZero())
+
+			_, err = get(restcfg, "")
+			Expect(err).To(MatchError(ContainSubstring("context deadline exceeded")))
+
+			data, err := get(restcfg, "?watch=true")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal("done"))
+		})
+
+		It("does not limit requests by default", func() {
+			restcfg, err := restConfig(def, configFor(), kubeconfig)
+			Expect(err).NotTo(HaveOccurred())
+			restcfg.Host = server.URL
+
+			data, err := get(restcfg, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(Equal("done"))
+		})
+	})
+})
@@ -35,6 +35,9 @@ type Config struct {
 	idents        string
 	CRDMaintainer MaintainerInfo
 
+	SecretCluster      string
+	ValueRefreshPeriod time.Duration
+
 	config.OptionSet
 }
 
@@ -51,6 +54,8 @@ func NewConfig(name string) *Config {
 	cfg.AddStringOption(&cfg.idents, "accepted-maintainers", "", "", "accepted maintainer key(s) for crds")
 	cfg.AddStringOption(&cfg.CRDMaintainer.Ident, "maintainer", "", name, "maintainer key for crds")
 	cfg.AddBoolOption(&cfg.CRDMaintainer.ForceCRDUpdate, "force-crd-update", "", false, "enforce update of crds even they are unmanaged")
+	cfg.AddStringOption(&cfg.SecretCluster, "secret-reference-cluster", "", "default", "cluster used to read secrets for option values given by secret:// references")
+	cfg.AddDurationOption(&cfg.ValueRefreshPeriod, "value-reference-refresh-period", "", 0, "period to resolve option values given by references again (0 disables the refresh)")
 	return cfg
 }
 
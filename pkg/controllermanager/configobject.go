@@ -0,0 +1,235 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controllermanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+	"github.com/gardener/controller-manager-library/pkg/configmain"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/apis/config/crds"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/config"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources/apiextensions"
+	"github.com/gardener/controller-manager-library/pkg/run"
+)
+
+// Configuration sources reported for the options
+// in the status of a config object.
+const (
+	SOURCE_FLAG          = "flag"
+	SOURCE_ENVIRONMENT   = "environment"
+	SOURCE_CONFIG_FILE   = "config file"
+	SOURCE_CONFIG_OBJECT = "config object"
+)
+
+func init() {
+	crds.AddToRegistry(apiextensions.DefaultRegistry())
+}
+
+// optionSources maps the names of the flags explicitly set
+// to the configuration source setting them.
+type optionSources map[string]string
+
+// record assigns the flags set since the last call to the given source.
+func (this optionSources) record(flags *pflag.FlagSet, source string) {
+	flags.Visit(func(f *pflag.Flag) {
+		if _, ok := this[f.Name]; !ok {
+			this[f.Name] = source
+		}
+	})
+}
+
+// names returns the names of the flags set by the given source.
+func (this optionSources) names(source string) []string {
+	var names []string
+	for name, s := range this {
+		if s == source {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// configObject is a ControllerManagerConfiguration object used as
+// configuration source. It is read through the default cluster.
+type configObject struct {
+	namespace string
+	name      string
+	client    dynamic.ResourceInterface
+	object    *v1alpha1.ControllerManagerConfiguration
+}
+
+// readConfigObject reads a config object and merges it into the flag set.
+// Without explicit namespace the namespace option is used, after the
+// other configuration sources have been merged.
+func readConfigObject(ctx context.Context, flags *pflag.FlagSet, cfg *configmain.Config, namespace, name string, sources optionSources) (*configObject, error) {
+	if namespace == "" {
+		if f := flags.Lookup("namespace"); f != nil {
+			namespace = f.Value.String()
+		}
+	}
+	if namespace == "" {
+		namespace = run.GetConfig(cfg).Namespace
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("no namespace for config object %q", name)
+	}
+	object, err := newConfigObject(cfg, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("reading config from %s", object)
+	if err := object.merge(ctx, flags, sources); err != nil {
+		return nil, err
+	}
+	sources.record(flags, SOURCE_CONFIG_OBJECT)
+	return object, nil
+}
+
+func newConfigObject(cfg *configmain.Config, namespace, name string) (*configObject, error) {
+	restcfg, err := cluster.DefaultRestConfig(areacfg.GetConfig(cfg))
+	if err != nil {
+		return nil, err
+	}
+	client, err := dynamic.NewForConfig(restcfg)
+	if err != nil {
+		return nil, err
+	}
+	return &configObject{
+		namespace: namespace,
+		name:      name,
+		client:    client.Resource(v1alpha1.SchemeGroupVersion.WithResource("controllermanagerconfigurations")).Namespace(namespace),
+	}, nil
+}
+
+func (this *configObject) String() string {
+	return fmt.Sprintf("ControllerManagerConfiguration %s/%s", this.namespace, this.name)
+}
+
+// read reads the object and returns its spec as config data.
+func (this *configObject) read(ctx context.Context) ([]byte, error) {
+	u, err := this.client.Get(ctx, this.name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %s", this, err)
+	}
+	obj := &v1alpha1.ControllerManagerConfiguration{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", this, err)
+	}
+	this.object = obj
+	if obj.Spec == nil || len(obj.Spec.Raw) == 0 {
+		return []byte("{}"), nil
+	}
+	return obj.Spec.Raw, nil
+}
+
+// merge reads the object, validates it and merges it into the flag set.
+// Like the config file it does not override flags and environment
+// variables. Unlike two config files, which would be merged with the first
+// one taking precedence, options set by both, the config file and the
+// object, are rejected to avoid ambiguous settings. Validation errors are
+// reported in the status of the object.
+func (this *configObject) merge(ctx context.Context, flags *pflag.FlagSet, sources optionSources) error {
+	data, err := this.read(ctx)
+	if err != nil {
+		return err
+	}
+	validation := config.ValidateConfig(this.String(), data, flags)
+	if err := validation.Error(); err != nil {
+		this.updateStatus(ctx, err, nil, nil)
+		return err
+	}
+	args, err := config.ParseConfig(data, flags)
+	if err != nil {
+		this.updateStatus(ctx, err, nil, nil)
+		return err
+	}
+
+	var problems config.ConfigProblems
+	valid := map[string]bool{}
+	err = flags.ParseAll(args, func(flag *pflag.Flag, value string) error {
+		if sources[flag.Name] == SOURCE_CONFIG_FILE {
+			if !valid[flag.Name] {
+				problems = append(problems, &config.ConfigProblem{File: this.String(), Path: flag.Name, Message: "already set by config file"})
+				valid[flag.Name] = true
+			}
+			return nil
+		}
+		if !flag.Changed || valid[flag.Name] {
+			valid[flag.Name] = true
+			return flags.Set(flag.Name, value)
+		}
+		return nil
+	})
+	if err == nil && len(problems) > 0 {
+		err = problems
+	}
+	if err != nil {
+		this.updateStatus(ctx, err, nil, nil)
+		return err
+	}
+	return nil
+}
+
+// updateStatus reports the validation result, the sources of the options
+// and the effective configuration of an option set. Problems writing the
+// status are only logged.
+func (this *configObject) updateStatus(ctx context.Context, err error, sources optionSources, set config.Options) {
+	if this.object == nil {
+		return
+	}
+	status := v1alpha1.ControllerManagerConfigurationStatus{
+		ObservedGeneration: this.object.Generation,
+		State:              v1alpha1.StateValid,
+	}
+	if err != nil {
+		status.State = v1alpha1.StateInvalid
+		if problems, ok := err.(config.ConfigProblems); ok {
+			for _, p := range problems {
+				status.Problems = append(status.Problems, p.String())
+			}
+		} else {
+			status.Problems = strings.Split(err.Error(), "\n")
+		}
+	}
+	if len(sources) > 0 {
+		status.Options = map[string]string{}
+		for name, source := range sources {
+			status.Options[name] = source
+		}
+	}
+	if set != nil {
+		data, jerr := json.Marshal(config.EffectiveConfig(set))
+		if jerr != nil {
+			logger.Warnf("cannot report effective config in %s: %s", this, jerr)
+		} else {
+			status.Config = &runtime.RawExtension{Raw: data}
+		}
+	}
+	this.object.Status = status
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(this.object)
+	if err == nil {
+		_, err = this.client.UpdateStatus(ctx, &unstructured.Unstructured{Object: u}, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		logger.Warnf("cannot update status of %s: %s", this, err)
+	}
+}
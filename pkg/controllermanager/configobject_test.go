@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package controllermanager
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/controller-manager-library/pkg/resources/apiextensions"
+)
+
+var _ = Describe("Config object", func() {
+	gvr := v1alpha1.SchemeGroupVersion.WithResource("controllermanagerconfigurations")
+
+	var (
+		ctx     context.Context
+		flags   *pflag.FlagSet
+		sources optionSources
+		object  *configObject
+	)
+
+	newObject := func(spec string) *configObject {
+		obj := &v1alpha1.ControllerManagerConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "config", Generation: 3},
+			Spec:       &runtime.RawExtension{Raw: []byte(spec)},
+		}
+		obj.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind("ControllerManagerConfiguration"))
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		Expect(err).To(Succeed())
+		client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "ControllerManagerConfigurationList"},
+			&unstructured.Unstructured{Object: u})
+		return &configObject{
+			namespace: "default",
+			name:      "config",
+			client:    client.Resource(gvr).Namespace("default"),
+		}
+	}
+
+	status := func() v1alpha1.ControllerManagerConfigurationStatus {
+		u, err := object.client.Get(ctx, "config", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		obj := &v1alpha1.ControllerManagerConfiguration{}
+		Expect(runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)).To(Succeed())
+		return obj.Status
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.String("namespace", "", "namespace")
+		flags.String("name", "", "name")
+		flags.String("password", "", "password")
+		flags.Int("workers", 1, "workers")
+		sources = optionSources{}
+	})
+
+	It("records the source of the options set since the last call", func() {
+		Expect(flags.Parse([]string{"--name=flag"})).To(Succeed())
+		sources.record(flags, SOURCE_FLAG)
+		Expect(flags.Set("name", "env")).To(Succeed())
+		Expect(flags.Set("workers", "2")).To(Succeed())
+		sources.record(flags, SOURCE_ENVIRONMENT)
+		Expect(sources).To(Equal(optionSources{"name": SOURCE_FLAG, "workers": SOURCE_ENVIRONMENT}))
+	})
+
+	It("merges the object without overriding flags", func() {
+		object = newObject(`{"name": "object", "workers": 5, "password": "secret"}`)
+		Expect(flags.Parse([]string{"--name=flag"})).To(Succeed())
+		sources.record(flags, SOURCE_FLAG)
+
+		Expect(object.merge(ctx, flags, sources)).To(Succeed())
+		sources.record(flags, SOURCE_CONFIG_OBJECT)
+
+		Expect(flags.Lookup("name").Value.String()).To(Equal("flag"))
+		Expect(flags.Lookup("workers").Value.String()).To(Equal("5"))
+		Expect(sources).To(Equal(optionSources{
+			"name":     SOURCE_FLAG,
+			"workers":  SOURCE_CONFIG_OBJECT,
+			"password": SOURCE_CONFIG_OBJECT,
+		}))
+	})
+
+	It("rejects options already set by the config file", func() {
+		object = newObject(`{"name": "object", "workers": 5}`)
+		Expect(flags.Set("workers", "2")).To(Succeed())
+		sources.record(flags, SOURCE_CONFIG_FILE)
+
+		err := object.merge(ctx, flags, sources)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("workers: already set by config file"))
+		Expect(flags.Lookup("workers").Value.String()).To(Equal("2"))
+
+		s := status()
+		Expect(s.State).To(Equal(v1alpha1.StateInvalid))
+		Expect(s.Problems).To(ConsistOf(ContainSubstring("workers: already set by config file")))
+	})
+
+	It("reports validation problems in the status", func() {
+		object = newObject(`{"workerz": 5}`)
+		Expect(object.merge(ctx, flags, sources)).NotTo(Succeed())
+
+		s := status()
+		Expect(s.State).To(Equal(v1alpha1.StateInvalid))
+		Expect(s.ObservedGeneration).To(Equal(int64(3)))
+		Expect(s.Problems).To(HaveLen(1))
+		Expect(s.Problems[0]).To(ContainSubstring("workerz"))
+	})
+
+	It("reports the option sources and the effective config with redacted values", func() {
+		set := config.NewDefaultOptionSet("main", "")
+		set.AddStringOption(nil, "name", "", "", "name")
+		set.AddStringOption(nil, "password", "", "", "password")
+		set.AddIntOption(nil, "workers", "", 1, "workers")
+		config.MarkSensitive(set, "password")
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		set.AddToFlags(flags)
+
+		object = newObject(`{"password": "secret", "workers": 2}`)
+		Expect(flags.Parse([]string{"--name=flag"})).To(Succeed())
+		sources.record(flags, SOURCE_FLAG)
+		Expect(object.merge(ctx, flags, sources)).To(Succeed())
+		sources.record(flags, SOURCE_CONFIG_OBJECT)
+		Expect(sources.names(SOURCE_CONFIG_OBJECT)).To(Equal([]string{"password", "workers"}))
+
+		object.updateStatus(ctx, nil, sources, set)
+
+		s := status()
+		Expect(s.State).To(Equal(v1alpha1.StateValid))
+		Expect(s.Problems).To(BeEmpty())
+		Expect(s.Options).To(Equal(map[string]string{"name": SOURCE_FLAG, "password": SOURCE_CONFIG_OBJECT, "workers": SOURCE_CONFIG_OBJECT}))
+		Expect(s.Config).NotTo(BeNil())
+		Expect(s.Config.Raw).To(MatchJSON(`{"name": "flag", "password": "(redacted)", "workers": 2}`))
+		u, err := object.client.Get(ctx, "config", metav1.GetOptions{})
+		Expect(err).To(Succeed())
+		data, _, _ := unstructured.NestedMap(u.Object, "status")
+		Expect(fmt.Sprint(data)).NotTo(ContainSubstring("secret"))
+	})
+
+	It("registers the CRD in the default registry", func() {
+		Expect(apiextensions.GetCRDs(v1alpha1.SchemeGroupVersion.WithKind("ControllerManagerConfiguration").GroupKind())).NotTo(BeNil())
+	})
+})
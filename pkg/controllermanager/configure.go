@@ -7,6 +7,7 @@
 package controllermanager
 
 import (
+	"github.com/gardener/controller-manager-library/pkg/config"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
 	resources "github.com/gardener/controller-manager-library/pkg/resources/plain"
@@ -27,6 +28,7 @@ type Configuration struct {
 	globalMinimalWatch  resources.GroupKindSet
 	clusterMinimalWatch map[string]resources.GroupKindSet
 	groupKindMigrations []schema.GroupKind
+	envPrefix           *string
 }
 
 type configState struct {
@@ -89,6 +91,14 @@ func (this Configuration) MinimalWatch(clusterName string, groupKinds ...schema.
 	return this
 }
 
+// EnvironmentPrefix sets the prefix for the environment variables used
+// to set options. By default, the name of the controller manager is used.
+// An empty prefix uses the plain option names.
+func (this Configuration) EnvironmentPrefix(prefix string) Configuration {
+	this.envPrefix = &prefix
+	return this
+}
+
 func (this Configuration) RegisterExtension(reg extension.ExtensionType) error {
 	return this.extension_reg.RegisterExtension(reg)
 }
@@ -118,11 +128,16 @@ func (this Configuration) Definition() Definition {
 		}
 		return configuration
 	})
+	envPrefix := config.EnvironmentName("", this.name)
+	if this.envPrefix != nil {
+		envPrefix = *this.envPrefix
+	}
 	return &_Definition{
 		name:         this.name,
 		description:  this.description,
 		extensions:   this.extension_reg.GetDefinitions(),
 		cluster_defs: cluster_defs,
 		gkMigrations: this.groupKindMigrations,
+		envPrefix:    envPrefix,
 	}
 }
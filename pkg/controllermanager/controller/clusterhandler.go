@@ -91,10 +91,12 @@ func (c *ClusterHandler) register(def *watchDef, namespace string, optionsFunc r
 
 		if def.Minimal || c.cluster.Definition().IsMinimalWatchEnforced(resourceKey.GroupKind()) {
 			if err := resource.AddSelectedInfoEventHandler(c.GetInfoEventHandlerFuncs(), namespace, optionsFunc); err != nil {
+				delete(c.resources, resourceKey)
 				return err
 			}
 		} else {
 			if err := resource.AddSelectedEventHandler(c.GetEventHandlerFuncs(), namespace, optionsFunc); err != nil {
+				delete(c.resources, resourceKey)
 				return err
 			}
 		}
@@ -162,6 +164,9 @@ func (c *ClusterHandler) EnqueueKey(key resources.ClusterObjectKey) error {
 
 func (c *ClusterHandler) enqueue(obj resources.ObjectInfo, e func(p *pool, r resources.ObjectInfo)) error {
 	c.whenReady()
+	if c.controller.dropEvent(obj) {
+		return nil
+	}
 	// c.Infof("enqueue %s", obj.Description())
 	i := c.resources[GetResourceKey(obj)]
 	if len(i.pools) == 0 {
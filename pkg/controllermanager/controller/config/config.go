@@ -15,8 +15,15 @@ import (
 const OPTION_SOURCE = "controllers"
 
 type Config struct {
-	Controllers string
-	Lease       lease.Config
+	Controllers          string
+	DebugEndpoints       bool
+	ReconcileHistorySize int
+	SkipUnsynced         bool
+	Lease                lease.Config
+
+	DynamicClustersNamespace string
+	DynamicClustersSelector  string
+	DynamicClustersKey       string
 
 	config.OptionSet
 }
@@ -28,7 +35,13 @@ func NewConfig() *Config {
 		OptionSet: config.NewSharedOptionSet(OPTION_SOURCE, ""),
 	}
 	cfg.AddStringOption(&cfg.Controllers, "controllers", "c", "all", "comma separated list of controllers to start (<name>,<group>,all)")
+	cfg.AddBoolOption(&cfg.DebugEndpoints, "controller-debug-endpoints", "", false, "serve debug endpoints for controllers (/debug/controllers/...)")
+	cfg.AddIntOption(&cfg.ReconcileHistorySize, "controller-reconcile-history-size", "", 100, "number of reconcile calls recorded per controller (0 to disable)")
+	cfg.AddBoolOption(&cfg.SkipUnsynced, "controller-skip-unsynced", "", false, "skip controllers whose watched resources cannot be synced within the cache sync timeout of their cluster instead of failing")
 	cfg.Lease.AddOptionsToSet(cfg.OptionSet)
+	cfg.AddStringOption(&cfg.DynamicClustersNamespace, "dynamic-clusters-namespace", "", "", "namespace of the kubeconfig secrets for dynamic clusters (default: namespace of the controller manager)")
+	cfg.AddStringOption(&cfg.DynamicClustersSelector, "dynamic-clusters-selector", "", "", "label selector for the kubeconfig secrets for dynamic clusters")
+	cfg.AddStringOption(&cfg.DynamicClustersKey, "dynamic-clusters-key", "", "kubeconfig", "secret data key of the kubeconfig for dynamic clusters")
 	return cfg
 }
 
@@ -8,8 +8,10 @@ package controller
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -21,6 +23,7 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
 	"github.com/gardener/controller-manager-library/pkg/resources"
 	"github.com/gardener/controller-manager-library/pkg/resources/apiextensions"
+	"github.com/gardener/controller-manager-library/pkg/resources/conditions"
 
 	"github.com/gardener/controller-manager-library/pkg/utils"
 )
@@ -192,6 +195,14 @@ func (this *rescdef) WatchResourceDef(wctx WatchContext) WatchResourceDef {
 	return this.flavors.WatchResourceDef(wctx, WatchResourceDef{})
 }
 
+func (this *rescdef) ResourceKeys() []ResourceKey {
+	return this.flavors.ResourceKeys()
+}
+
+func (this *rescdef) ObjectTypes() []reflect.Type {
+	return this.flavors.ObjectTypes()
+}
+
 func (this rescdef) String() string {
 	return this.flavors.String()
 }
@@ -234,6 +245,7 @@ type _Definition struct {
 	name                 string
 	main                 *rescdef
 	reconcilers          map[string]ReconcilerType
+	types                map[string]reflect.Type
 	syncers              map[string]SyncerDefinition
 	watches              map[string][]*watchdef
 	commands             Commands
@@ -245,11 +257,13 @@ type _Definition struct {
 	required_controllers []string
 	require_lease        bool
 	lease_cluster        string
+	dynamic_cluster      string
 	pools                map[string]PoolDefinition
 	configs              extension.OptionDefinitions
 	configsources        extension.OptionSourceDefinitions
 	finalizerName        string
 	finalizerDomain      string
+	reconcileCondition   *conditions.ConditionType
 	crds                 map[string][]*apiextensions.CustomResourceDefinitionVersions
 	activateExplicitly   bool
 	scheme               *runtime.Scheme
@@ -277,6 +291,9 @@ func (this *_Definition) String() string {
 	if this.require_lease {
 		s += fmt.Sprintf("  lease on:    %s\n", this.LeaseClusterName())
 	}
+	if this.dynamic_cluster != "" {
+		s += fmt.Sprintf("  dynamic:     %s\n", this.dynamic_cluster)
+	}
 	if this.scheme != nil {
 		s += "  scheme is set\n"
 	}
@@ -374,6 +391,9 @@ func (this *_Definition) LeaseClusterName() string {
 	}
 	return CLUSTER_MAIN
 }
+func (this *_Definition) DynamicCluster() string {
+	return this.dynamic_cluster
+}
 func (this *_Definition) FinalizerName() string {
 	if this.finalizerName == "" {
 		return FinalizerName(this.finalizerDomain, this.name)
@@ -381,6 +401,10 @@ func (this *_Definition) FinalizerName() string {
 	return this.finalizerName
 }
 
+func (this *_Definition) ReconcileCondition() *conditions.ConditionType {
+	return this.reconcileCondition
+}
+
 func (this *_Definition) CustomResourceDefinitions() map[string][]*apiextensions.CustomResourceDefinitionVersions {
 	crds := map[string][]*apiextensions.CustomResourceDefinitionVersions{}
 	for n, l := range this.crds {
@@ -396,6 +420,13 @@ func (this *_Definition) Reconcilers() map[string]ReconcilerType {
 	}
 	return types
 }
+func (this *_Definition) ReconcilerTypes() map[string]reflect.Type {
+	types := map[string]reflect.Type{}
+	for n, t := range this.types {
+		types[n] = t
+	}
+	return types
+}
 func (this *_Definition) Syncers() map[string]SyncerDefinition {
 	syncers := map[string]SyncerDefinition{}
 	for n, d := range this.syncers {
@@ -880,10 +911,12 @@ func (this Configuration) Reconciler(t ReconcilerType, name ...string) Configura
 	this.pushState()
 	if len(name) == 0 {
 		this.settings.reconcilers[DEFAULT_RECONCILER] = t
+		delete(this.settings.types, DEFAULT_RECONCILER)
 		this.reconciler = DEFAULT_RECONCILER
 	} else {
 		for _, n := range name {
 			this.settings.reconcilers[n] = t
+			delete(this.settings.types, n)
 			this.reconciler = n
 		}
 	}
@@ -895,6 +928,13 @@ func (this Configuration) FinalizerName(name string) Configuration {
 	return this
 }
 
+// ReconcileCondition configures a condition type used to reflect the
+// outcome of the last reconcilation in the status of main resource objects.
+func (this Configuration) ReconcileCondition(ctype *conditions.ConditionType) Configuration {
+	this.settings.reconcileCondition = ctype
+	return this
+}
+
 func (this Configuration) FinalizerDomain(name string) Configuration {
 	this.settings.finalizerDomain = name
 	return this
@@ -918,6 +958,20 @@ func (this Configuration) RequireLease(clusters ...string) Configuration {
 	return this
 }
 
+// PerDynamicCluster declares the controller to be instantiated for every
+// member of the dynamic cluster set. The member is provided under the given
+// logical cluster name, which is added to the required clusters like Cluster.
+func (this Configuration) PerDynamicCluster(name string) Configuration {
+	if name == "" || name == CLUSTER_MAIN || name == cluster.DEFAULT {
+		panic(fmt.Sprintf("invalid dynamic cluster name %q", name))
+	}
+	if this.settings.dynamic_cluster != "" && this.settings.dynamic_cluster != name {
+		panic(fmt.Sprintf("dynamic cluster already set to %s", this.settings.dynamic_cluster))
+	}
+	this.settings.dynamic_cluster = name
+	return this.Cluster(name)
+}
+
 func (this Configuration) Scheme(scheme *runtime.Scheme) Configuration {
 	this.settings.scheme = scheme
 	return this
@@ -963,6 +1017,61 @@ func (this Configuration) DefaultedDurationOption(name string, def time.Duration
 	return this.addOption(name, config.DurationOption, def, desc)
 }
 
+func (this Configuration) StringMapOption(name string, desc string) Configuration {
+	return this.addOption(name, config.StringMapOption, nil, desc)
+}
+
+func (this Configuration) DefaultedStringMapOption(name string, def map[string]string, desc string) Configuration {
+	return this.addOption(name, config.StringMapOption, def, desc)
+}
+
+func (this Configuration) FloatOption(name string, desc string) Configuration {
+	return this.addOption(name, config.FloatOption, float64(0), desc)
+}
+
+func (this Configuration) DefaultedFloatOption(name string, def float64, desc string) Configuration {
+	return this.addOption(name, config.FloatOption, def, desc)
+}
+
+func (this Configuration) QuantityOption(name string, desc string) Configuration {
+	return this.addOption(name, config.QuantityOption, resource.Quantity{}, desc)
+}
+
+// DefaultedQuantityOption declares a quantity option. The
+// default must be a valid quantity, otherwise it panics.
+func (this Configuration) DefaultedQuantityOption(name string, def string, desc string) Configuration {
+	return this.addOption(name, config.QuantityOption, resource.MustParse(def), desc)
+}
+
+// EnumOption declares a string option restricted to the given values.
+// The default must be one of the values, otherwise it panics.
+func (this Configuration) EnumOption(name string, def string, values []string, desc string) Configuration {
+	found := false
+	for _, v := range values {
+		found = found || v == def
+	}
+	if !found {
+		panic(fmt.Sprintf("default %q for option %q is not one of %v", def, name, values))
+	}
+	return this.addOption(name, config.EnumOption(values...), def, desc)
+}
+
+func (this Configuration) IntSliceOption(name string, desc string) Configuration {
+	return this.addOption(name, config.IntSliceOption, nil, desc)
+}
+
+func (this Configuration) DefaultedIntSliceOption(name string, def []int, desc string) Configuration {
+	return this.addOption(name, config.IntSliceOption, def, desc)
+}
+
+func (this Configuration) DurationSliceOption(name string, desc string) Configuration {
+	return this.addOption(name, config.DurationSliceOption, nil, desc)
+}
+
+func (this Configuration) DefaultedDurationSliceOption(name string, def []time.Duration, desc string) Configuration {
+	return this.addOption(name, config.DurationSliceOption, def, desc)
+}
+
 func (this Configuration) addOption(name string, t config.OptionType, def interface{}, desc string) Configuration {
 	if this.settings.configs[name] != nil {
 		panic(fmt.Sprintf("option %q already defined", name))
@@ -17,6 +17,7 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/leaderelection"
@@ -33,6 +34,7 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
 	"github.com/gardener/controller-manager-library/pkg/resources/apiextensions"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 )
 
@@ -98,6 +100,11 @@ func (this *ReadyFlag) start() {
 	this.lock.Lock()
 }
 
+// release releases waiting goroutines without marking the flag as ready.
+func (this *ReadyFlag) release() {
+	this.lock.Unlock()
+}
+
 type watchContext struct {
 	cluster    cluster.Interface
 	controller Interface
@@ -151,6 +158,26 @@ func (this watchContext) GetDurationOption(name string) (time.Duration, error) {
 	return this.controller.GetDurationOption(name)
 }
 
+func (this watchContext) GetStringMapOption(name string) (map[string]string, error) {
+	return this.controller.GetStringMapOption(name)
+}
+
+func (this watchContext) GetFloatOption(name string) (float64, error) {
+	return this.controller.GetFloatOption(name)
+}
+
+func (this watchContext) GetQuantityOption(name string) (resource.Quantity, error) {
+	return this.controller.GetQuantityOption(name)
+}
+
+func (this watchContext) GetIntSliceOption(name string) ([]int, error) {
+	return this.controller.GetIntSliceOption(name)
+}
+
+func (this watchContext) GetDurationSliceOption(name string) ([]time.Duration, error) {
+	return this.controller.GetDurationSliceOption(name)
+}
+
 type watchDef struct {
 	WatchResourceDef
 	PoolName   string
@@ -170,8 +197,12 @@ type controller struct {
 	extension.SharedAttributes
 
 	ready           ReadyFlag
+	skipLock        sync.Mutex
+	skipped         error
+	dropped         int
 	definition      Definition
 	env             Environment
+	member          string
 	cluster         cluster.Interface
 	clusters        cluster.Clusters
 	filters         []ResourceFilter
@@ -183,6 +214,7 @@ type controller struct {
 	mappings        _Reconcilations
 	syncRequests    *SyncRequests
 	finalizer       Finalizer
+	history         *reconcileHistory
 
 	options  *ControllerConfig
 	handlers map[string]*ClusterHandler
@@ -216,6 +248,10 @@ func NewController(env Environment, def Definition, cmp mappings.Definition) (*c
 		reconcilerNames: map[reconcile.Interface]string{},
 		mappings:        _Reconcilations{},
 		finalizer:       NewDefaultFinalizer(def.FinalizerName()),
+		history:         newReconcileHistory(env.GetConfig().ReconcileHistorySize),
+	}
+	if d, ok := env.(*dynamicEnvironment); ok {
+		this.member = d.member.GetName()
 	}
 
 	this.syncRequests = NewSyncRequests(this)
@@ -248,6 +284,9 @@ func NewController(env Environment, def Definition, cmp mappings.Definition) (*c
 		PoolName:         DEFAULT_POOL,
 		Reconciler:       DEFAULT_RECONCILER,
 	}
+	if this.mainresc.Err != nil {
+		return nil, this.mainresc.Err
+	}
 
 	err = this.deployCRDS()
 	if err != nil {
@@ -260,6 +299,11 @@ func NewController(env Environment, def Definition, cmp mappings.Definition) (*c
 		if err != nil {
 			return nil, fmt.Errorf("creating reconciler %s failed: %s", n, err)
 		}
+		if t, ok := def.ReconcilerTypes()[n]; ok {
+			if typed, ok := reconciler.(reconcile.TypedInterface); ok && typed.ObjectType() != t {
+				return nil, fmt.Errorf("reconciler %s handles type %s, but is configured for type %s", n, typed.ObjectType(), t)
+			}
+		}
 		this.reconcilers[n] = reconciler
 		this.reconcilerNames[reconciler] = n
 	}
@@ -278,6 +322,9 @@ func NewController(env Environment, def Definition, cmp mappings.Definition) (*c
 				PoolName:         w.PoolName(),
 				Reconciler:       w.Reconciler(),
 			}
+			if def.Err != nil {
+				return nil, def.Err
+			}
 			key := def.Key
 			if key != nil {
 				if w.String() != key.String() {
@@ -362,6 +409,9 @@ func (this *controller) deployCRDS() error {
 		wctx := newWatchContext(this, cluster)
 		for _, w := range watches {
 			def := w.WatchResourceDef(wctx)
+			if def.Err != nil {
+				return def.Err
+			}
 			key := def.Key
 			if key != nil {
 				clusterResources.Add(cname, key.GroupKind())
@@ -452,6 +502,60 @@ func (this *controller) IsReady() bool {
 	return this.ready.IsReady()
 }
 
+// skip marks the controller as skipped, because its watches could not be set up.
+// Events for the watches already set up are dropped.
+func (this *controller) skip(err error) {
+	this.skipLock.Lock()
+	defer this.skipLock.Unlock()
+	this.skipped = err
+	this.ready.release()
+}
+
+// dropEvent checks whether the controller has been skipped. In this case
+// the event for the given object is counted as dropped and true is returned.
+// The handlers of a skipped controller remain registered at the shared
+// informers, therefore the first dropped event is logged as warning.
+func (this *controller) dropEvent(obj resources.ObjectInfo) bool {
+	this.skipLock.Lock()
+	defer this.skipLock.Unlock()
+	if this.skipped == nil {
+		return false
+	}
+	this.dropped++
+	if this.dropped == 1 {
+		this.Warnf("controller skipped, dropping events (first for %s): %s", obj.Description(), this.skipped)
+	} else {
+		this.Debugf("controller skipped, dropping event for %s (%d dropped)", obj.Description(), this.dropped)
+	}
+	return true
+}
+
+// skipState returns the number of dropped events and the reason
+// the controller has been skipped for, or nil.
+func (this *controller) skipState() (int, error) {
+	this.skipLock.Lock()
+	defer this.skipLock.Unlock()
+	return this.dropped, this.skipped
+}
+
+// readinessCheck reports a controller as ready, if it is running,
+// has explicitly been skipped or is waiting for the lease required to start it.
+// It is used as startup check, also.
+func (this *controller) readinessCheck(lease *leasestartupgroup) healthz.Checker {
+	return healthz.CheckerFunc(func() healthz.Status {
+		if this.IsReady() {
+			return healthz.NewStatus(this.GetName(), "")
+		}
+		if dropped, err := this.skipState(); err != nil {
+			return healthz.Status{Name: this.GetName(), Healthy: true, Reason: fmt.Sprintf("skipped (%d events dropped): %s", dropped, err)}
+		}
+		if lease != nil && !lease.leading() {
+			return healthz.Status{Name: this.GetName(), Healthy: true, Reason: "waiting for lease"}
+		}
+		return healthz.NewStatus(this.GetName(), "controller not started")
+	})
+}
+
 func (this *controller) GetReconciler(name string) reconcile.Interface {
 	return this.reconcilers[name]
 }
@@ -474,6 +578,9 @@ func (this *controller) addReconciler(cname string, spec ReconcilationElementSpe
 		aliases = this.clusters.GetAliases(cluster.GetName())
 	}
 	if gk, ok := spec.(schema.GroupKind); ok {
+		if err := this.checkReconcilerType(cluster, reconciler, gk); err != nil {
+			return false, err
+		}
 		if reject, ok := r.(reconcile.ReconcilationRejection); ok {
 			if reject.RejectResourceReconcilation(cluster, gk) {
 				this.Infof("reconciler %s rejects resource reconcilation resource %s for cluster %s",
@@ -537,6 +644,15 @@ func (this *controller) GetEnvironment() Environment {
 	return this.env
 }
 
+// instanceName returns the name of the controller, including the
+// dynamic cluster for controller instances per dynamic cluster.
+func (this *controller) instanceName() string {
+	if this.member != "" {
+		return this.GetName() + "@" + this.member
+	}
+	return this.GetName()
+}
+
 func (this *controller) GetDefinition() Definition {
 	return this.definition
 }
@@ -733,6 +849,8 @@ func (this *controller) prepare() error {
 
 func (this *controller) Run() {
 	this.ready.ready()
+	addRunningController(this)
+	defer removeRunningController(this)
 	this.Infof("starting pools...")
 	for _, p := range this.pools {
 		ctxutil.WaitGroupRunAndCancelOnExit(this.GetContext(), p.Run)
@@ -8,6 +8,7 @@ package controller
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/gardener/controller-manager-library/pkg/config"
 	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/controller/config"
@@ -16,14 +17,45 @@ import (
 
 type ControllerConfig struct {
 	config.OptionSet
+
+	lock  sync.Mutex
+	pools map[*pool]struct{}
 }
 
+var _ config.Reconfigurable = (*ControllerConfig)(nil)
+
 func NewControllerConfig(controller string) *ControllerConfig {
 	return &ControllerConfig{
 		OptionSet: config.NewSharedOptionSet(controller, controller, func(desc string) string {
 			return fmt.Sprintf("%s of controller %s", desc, controller)
 		}),
+		pools: map[*pool]struct{}{},
+	}
+}
+
+func (this *ControllerConfig) addPool(p *pool) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.pools[p] = struct{}{}
+}
+
+func (this *ControllerConfig) removePool(p *pool) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	delete(this.pools, p)
+}
+
+// Reconfigure adapts the size of the running pools
+// if it is changed in the config file.
+func (this *ControllerConfig) Reconfigure(changes config.OptionChanges) error {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	for p := range this.pools {
+		if c := changes[p.GetName()+"."+POOL_SIZE_OPTION]; c != nil {
+			p.resize(c.New.(int))
+		}
 	}
+	return nil
 }
 
 const POOL_SIZE_OPTION = "pool.size"
@@ -45,6 +77,7 @@ func (this *_Definitions) ExtendConfig(cfg *areacfg.Config) {
 			})
 			set.AddSource(pname, pcfg)
 			pcfg.AddIntOption(nil, POOL_SIZE_OPTION, "", p.Size(), "Worker pool size")
+			config.MarkReloadable(pcfg, POOL_SIZE_OPTION)
 
 			if p.Period() != 0 {
 				pcfg.AddDurationOption(nil, POOL_RESYNC_PERIOD_OPTION, "", p.Period(), "Period for resynchronization")
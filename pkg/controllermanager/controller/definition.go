@@ -96,6 +96,13 @@ func (this *_Definitions) DetermineRequestedClusters(cdefs cluster.Definitions,
 			return nil, nil, fmt.Errorf("controller %q not definied", n)
 		}
 		names := cluster.Canonical(def.RequiredClusters())
+		refs := def.CrossClusterReferences()
+		if dyn := def.DynamicCluster(); dyn != "" {
+			// dynamic clusters are provided at runtime, but the kubeconfig
+			// secrets are watched on the default cluster
+			names = dynamicClusterNames(names, dyn)
+			refs = refs.Without(dyn)
+		}
 
 		cmp, err := this.GetMappingsFor(def.Name())
 		if err != nil {
@@ -113,13 +120,21 @@ func (this *_Definitions) DetermineRequestedClusters(cdefs cluster.Definitions,
 		}
 		clusters.AddSet(set)
 		logger.Infof("  mapped to %s", utils.Strings(found...))
-		def.CrossClusterReferences().Map(mapping)
-
-		withids.AddAll(def.CrossClusterReferences().Map(mapping))
+		withids.AddAll(refs.Map(mapping))
 	}
 	return clusters, withids, nil
 }
 
+func dynamicClusterNames(names []string, dynamic string) []string {
+	result := []string{}
+	for _, n := range names {
+		if n != dynamic {
+			result = append(result, n)
+		}
+	}
+	return cluster.Canonical(append(result, cluster.DEFAULT))
+}
+
 func (this *_Definitions) Registrations(names ...string) (Registrations, error) {
 	this.lock.RLock()
 	defer this.lock.RUnlock()
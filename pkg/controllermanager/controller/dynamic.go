@@ -0,0 +1,321 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/leaderelection"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/controller/config"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/lease"
+	"github.com/gardener/controller-manager-library/pkg/ctxutil"
+)
+
+// dynamicEnvironment is the environment for the controllers of a member
+// of the dynamic cluster set. It provides the static clusters plus the
+// member under the logical dynamic cluster names and a dedicated context,
+// which is cancelled when the member is removed.
+type dynamicEnvironment struct {
+	*Extension
+	ctx      context.Context
+	config   *areacfg.Config
+	member   cluster.Interface
+	clusters cluster.Clusters
+}
+
+var _ Environment = &dynamicEnvironment{}
+
+func (this *dynamicEnvironment) GetContext() context.Context {
+	return this.ctx
+}
+
+// GetConfig returns the area config with a lease name specific
+// for the member.
+func (this *dynamicEnvironment) GetConfig() *areacfg.Config {
+	return this.config
+}
+
+func (this *dynamicEnvironment) GetCluster(name string) cluster.Interface {
+	return this.clusters.GetCluster(name)
+}
+
+func (this *dynamicEnvironment) GetClusters() cluster.Clusters {
+	return this.clusters
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type dynamicMember struct {
+	env         *dynamicEnvironment
+	controllers controllers
+}
+
+// dynamicClusterProvider provides the members of the dynamic cluster set.
+type dynamicClusterProvider interface {
+	Start() error
+	Restart(member cluster.Interface)
+}
+
+var _ dynamicClusterProvider = &cluster.DynamicClusters{}
+
+// dynamicControllers manages the controller instances
+// for the members of the dynamic cluster set.
+type dynamicControllers struct {
+	extension     *Extension
+	registrations Registrations
+	provider      dynamicClusterProvider
+
+	lock    sync.RWMutex
+	members map[string]*dynamicMember
+}
+
+var _ cluster.DynamicClusterHandler = &dynamicControllers{}
+
+func newDynamicControllers(ext *Extension, regs Registrations) (*dynamicControllers, error) {
+	cfg := ext.config
+	spec := cluster.DynamicClusterSpec{
+		Namespace: cfg.DynamicClustersNamespace,
+		Key:       cfg.DynamicClustersKey,
+		Scheme:    ext.ClusterDefinitions().GetScheme(),
+	}
+	if spec.Namespace == "" {
+		spec.Namespace = ext.Namespace()
+	}
+	if cfg.DynamicClustersSelector != "" {
+		sel, err := labels.Parse(cfg.DynamicClustersSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dynamic cluster selector %q: %s", cfg.DynamicClustersSelector, err)
+		}
+		spec.Selector = sel
+	}
+	source := ext.GetCluster(cluster.DEFAULT)
+	if source == nil {
+		return nil, fmt.Errorf("cluster %q for dynamic clusters not found", cluster.DEFAULT)
+	}
+
+	this := &dynamicControllers{
+		extension:     ext,
+		registrations: regs,
+		members:       map[string]*dynamicMember{},
+	}
+	this.provider = cluster.NewDynamicClusters(ext.GetContext(), ext.NewContext("dynamic", "clusters"), source, spec, this)
+	return this, nil
+}
+
+func (this *dynamicControllers) Start() error {
+	return this.provider.Start()
+}
+
+func (this *dynamicControllers) newEnvironment(member cluster.Interface) (*dynamicEnvironment, error) {
+	ext := this.extension
+	static := ext.GetClusters()
+	clusters := cluster.NewClusters(static.Cache())
+	for n := range static.Names() {
+		clusters.Add(n, static.GetCluster(n))
+	}
+	for _, def := range this.registrations {
+		name := def.DynamicCluster()
+		if c := static.GetCluster(name); c != nil {
+			return nil, fmt.Errorf("dynamic cluster name %q of controller %q conflicts with static cluster", name, def.Name())
+		}
+		clusters.Add(name, member, fmt.Sprintf("dynamic cluster %s", member.GetName()))
+	}
+
+	cfg := *ext.config
+	cfg.Lease.LeaseName = fmt.Sprintf("%s-%s", cfg.Lease.LeaseName, member.GetName())
+
+	ctx := ctxutil.CancelContext(ctxutil.WaitGroupContext(ext.GetContext(), "dynamic cluster ", member.GetName()))
+	return &dynamicEnvironment{
+		Extension: ext,
+		ctx:       ctx,
+		config:    &cfg,
+		member:    member,
+		clusters:  clusters,
+	}, nil
+}
+
+// ClusterAdded creates and starts the controller instances for a new member.
+func (this *dynamicControllers) ClusterAdded(member cluster.Interface) error {
+	ext := this.extension
+	env, err := this.newEnvironment(member)
+	if err != nil {
+		return err
+	}
+
+	m := &dynamicMember{env: env}
+	for _, def := range this.registrations {
+		ext.Infof("creating controller %q for dynamic cluster %q", def.Name(), member.GetName())
+		cmp, err := ext.definitions.GetMappingsFor(def.Name())
+		if err != nil {
+			ctxutil.Cancel(env.ctx)
+			return err
+		}
+		cntr, err := NewController(env, def, cmp)
+		if err != nil {
+			if f := def.DeactivateOnCreationErrorCheck(); f != nil && f(err) {
+				ext.Infof("deactivating controller %s for dynamic cluster %q because of: %s", def.Name(), member.GetName(), err)
+				continue
+			}
+			ctxutil.Cancel(env.ctx)
+			return err
+		}
+		if err = cntr.check(); err != nil {
+			ctxutil.Cancel(env.ctx)
+			return err
+		}
+		m.controllers = append(m.controllers, cntr)
+	}
+	m.controllers, err = m.controllers.getOrder(ext)
+	if err != nil {
+		ctxutil.Cancel(env.ctx)
+		return err
+	}
+
+	this.lock.Lock()
+	this.members[member.GetName()] = m
+	this.lock.Unlock()
+
+	ctxutil.WaitGroupRun(ext.GetContext(), func() { this.run(m) })
+	return nil
+}
+
+// ClusterRemoved stops the controller instances of a removed member.
+func (this *dynamicControllers) ClusterRemoved(member cluster.Interface) {
+	this.lock.Lock()
+	m := this.members[member.GetName()]
+	delete(this.members, member.GetName())
+	this.lock.Unlock()
+
+	if m != nil {
+		this.extension.Infof("stopping controllers for dynamic cluster %q", member.GetName())
+		ctxutil.Cancel(m.env.ctx)
+	}
+	if this.extension.GetClusters().GetById(member.GetId()) == nil {
+		this.extension.GetClusters().Cache().Cleanup(member.GetId())
+	}
+}
+
+func (this *dynamicControllers) run(m *dynamicMember) {
+	ext := this.extension
+	plain := &startupgroup{extension: ext, cluster: m.env.member}
+	leased := map[string]*startupgroup{}
+	for _, cntr := range m.controllers {
+		def := cntr.GetDefinition()
+		if def.RequireLease() {
+			c := cntr.GetCluster(def.LeaseClusterName())
+			g := leased[c.GetName()]
+			if g == nil {
+				g = &startupgroup{extension: ext, cluster: c}
+				leased[c.GetName()] = g
+			}
+			g.Add(cntr)
+		} else {
+			plain.Add(cntr)
+		}
+	}
+
+	if err := plain.Startup(); err != nil {
+		ext.Errorf("cannot start controllers for dynamic cluster %q: %s", m.env.member.GetName(), err)
+		ctxutil.Cancel(m.env.ctx)
+	}
+	for _, g := range leased {
+		if err := this.startLeased(m, g); err != nil {
+			ext.Errorf("cannot start controllers for dynamic cluster %q: %s", m.env.member.GetName(), err)
+			ctxutil.Cancel(m.env.ctx)
+		}
+	}
+
+	<-m.env.ctx.Done()
+	ctxutil.WaitGroupWait(m.env.ctx, 120*time.Second, "dynamic cluster ", m.env.member.GetName())
+	ext.Infof("controllers for dynamic cluster %q down now", m.env.member.GetName())
+	this.stopped(m)
+}
+
+// stopped handles the end of the controllers of a member. If they stopped
+// on their own (startup failure or lost lease) and not because the member
+// has been removed, the member is dropped and recreated by the provider,
+// so that the controllers are started again or compete for the lease.
+func (this *dynamicControllers) stopped(m *dynamicMember) {
+	name := m.env.member.GetName()
+	this.lock.Lock()
+	current := this.members[name] == m
+	if current {
+		delete(this.members, name)
+	}
+	this.lock.Unlock()
+
+	if current && this.extension.GetContext().Err() == nil {
+		this.extension.Infof("controllers for dynamic cluster %q stopped unexpectedly -> restarting", name)
+		this.provider.Restart(m.env.member)
+	}
+}
+
+// startLeased starts a group of controller instances of a member under a
+// member specific lease. Losing the lease stops the controllers of the
+// member instead of the controller manager. The member is recreated
+// afterwards to compete for the lease again.
+func (this *dynamicControllers) startLeased(m *dynamicMember, g *startupgroup) error {
+	leasecfg := m.env.config.Lease
+	if leasecfg.OmitLease {
+		ctxutil.WaitGroupRun(m.env.ctx, func() {
+			if err := g.Startup(); err != nil {
+				this.extension.Errorf("cannot start controllers for dynamic cluster %q: %s", m.env.member.GetName(), err)
+				ctxutil.Cancel(m.env.ctx)
+			}
+		})
+		return nil
+	}
+
+	this.extension.Infof("requesting lease %q for dynamic cluster %q on cluster %s", leasecfg.LeaseName, m.env.member.GetName(), g.cluster.GetName())
+	leaderElectionConfig, err := lease.MakeLeaderElectionConfig(g.cluster, this.extension.Namespace(), &leasecfg)
+	if err != nil {
+		return err
+	}
+	leaderElectionConfig.Callbacks = leaderelection.LeaderCallbacks{
+		OnStartedLeading: func(ctx context.Context) {
+			go func() {
+				<-ctx.Done()
+				this.extension.Infof("lease %q stopped -> stopping controllers for dynamic cluster %q", leasecfg.LeaseName, m.env.member.GetName())
+				ctxutil.Cancel(m.env.ctx)
+			}()
+			if err := g.Startup(); err != nil {
+				this.extension.Errorf("cannot start controllers for dynamic cluster %q: %s", m.env.member.GetName(), err)
+				ctxutil.Cancel(m.env.ctx)
+			}
+		},
+		OnStoppedLeading: func() {
+			this.extension.Infof("Lost leadership %q for dynamic cluster %q.", leasecfg.LeaseName, m.env.member.GetName())
+		},
+	}
+	leaderElector, err := leaderelection.NewLeaderElector(*leaderElectionConfig)
+	if err != nil {
+		return fmt.Errorf("couldn't create leader elector: %v", err)
+	}
+	ctxutil.WaitGroupRun(m.env.ctx, func() { leaderElector.Run(m.env.ctx) })
+	return nil
+}
+
+// Controllers returns the actually running controller instances
+// for members of the dynamic cluster set.
+func (this *dynamicControllers) Controllers() controllers {
+	if this == nil {
+		return nil
+	}
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	result := controllers{}
+	for _, m := range this.members {
+		result = append(result, m.controllers...)
+	}
+	return result
+}
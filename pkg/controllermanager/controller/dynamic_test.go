@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
+	"github.com/gardener/controller-manager-library/pkg/ctxutil"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+type testControllerManager struct {
+	extension.ControllerManager
+	ctx      context.Context
+	clusters cluster.Clusters
+}
+
+func (this *testControllerManager) GetContext() context.Context {
+	return this.ctx
+}
+
+func (this *testControllerManager) NewContext(key, value string) logger.LogContext {
+	return logger.NewContext(key, value)
+}
+
+func (this *testControllerManager) GetClusters() cluster.Clusters {
+	return this.clusters
+}
+
+type testMemberCluster struct {
+	cluster.Interface
+	name string
+}
+
+func (this *testMemberCluster) GetName() string {
+	return this.name
+}
+
+func (this *testMemberCluster) GetId() string {
+	return this.name + "-id"
+}
+
+type testClusterProvider struct {
+	lock      sync.Mutex
+	restarted []cluster.Interface
+}
+
+func (this *testClusterProvider) Start() error {
+	return nil
+}
+
+func (this *testClusterProvider) Restart(member cluster.Interface) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.restarted = append(this.restarted, member)
+}
+
+func (this *testClusterProvider) Restarted() []cluster.Interface {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return append([]cluster.Interface(nil), this.restarted...)
+}
+
+var _ = Describe("Dynamic controllers", func() {
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		provider *testClusterProvider
+		dyn      *dynamicControllers
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		cm := &testControllerManager{ctx: ctx, clusters: cluster.NewClusters(nil)}
+		ext := &Extension{Environment: extension.NewDefaultEnvironment(nil, TYPE, cm)}
+		provider = &testClusterProvider{}
+		dyn = &dynamicControllers{
+			extension: ext,
+			provider:  provider,
+			members:   map[string]*dynamicMember{},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	addMember := func(name string) *dynamicMember {
+		member := &testMemberCluster{name: name}
+		m := &dynamicMember{
+			env: &dynamicEnvironment{
+				Extension: dyn.extension,
+				ctx:       ctxutil.CancelContext(ctxutil.WaitGroupContext(dyn.extension.GetContext(), "dynamic cluster ", name)),
+				member:    member,
+			},
+		}
+		dyn.lock.Lock()
+		dyn.members[name] = m
+		dyn.lock.Unlock()
+		return m
+	}
+
+	run := func(m *dynamicMember) <-chan struct{} {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			dyn.run(m)
+		}()
+		return done
+	}
+
+	It("restarts members whose controllers stopped on their own", func() {
+		m := addMember("member")
+		done := run(m)
+
+		// for example a lost lease
+		ctxutil.Cancel(m.env.ctx)
+		gomega.Eventually(done).Should(gomega.BeClosed())
+
+		gomega.Expect(provider.Restarted()).To(gomega.Equal([]cluster.Interface{m.env.member}))
+		gomega.Expect(dyn.members).To(gomega.BeEmpty())
+	})
+
+	It("does not restart removed members", func() {
+		m := addMember("member")
+		done := run(m)
+
+		dyn.ClusterRemoved(m.env.member)
+		gomega.Eventually(done).Should(gomega.BeClosed())
+
+		gomega.Expect(provider.Restarted()).To(gomega.BeEmpty())
+		gomega.Expect(dyn.members).To(gomega.BeEmpty())
+	})
+
+	It("does not restart replaced members", func() {
+		m := addMember("member")
+		done := run(m)
+		next := addMember("member")
+
+		ctxutil.Cancel(m.env.ctx)
+		gomega.Eventually(done).Should(gomega.BeClosed())
+
+		gomega.Expect(provider.Restarted()).To(gomega.BeEmpty())
+		gomega.Expect(dyn.members).To(gomega.HaveKeyWithValue("member", next))
+	})
+
+	It("does not restart members on shutdown", func() {
+		m := addMember("member")
+		done := run(m)
+
+		cancel()
+		gomega.Eventually(done).Should(gomega.BeClosed())
+
+		gomega.Expect(provider.Restarted()).To(gomega.BeEmpty())
+	})
+})
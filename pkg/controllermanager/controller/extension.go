@@ -8,6 +8,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -19,6 +20,7 @@ import (
 	parentcfg "github.com/gardener/controller-manager-library/pkg/controllermanager/config"
 	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/controller/config"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
 	"github.com/gardener/controller-manager-library/pkg/ctxutil"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 )
@@ -123,9 +125,12 @@ type Extension struct {
 	plain_groups map[string]StartupGroup
 	lease_groups map[string]StartupGroup
 	prepared     map[string]*sync.SyncPoint
+	started      map[string]*sync.SyncPoint
 
 	clusters  utils.StringSet
 	crossrefs CrossClusterRefs
+
+	dynamic *dynamicControllers
 }
 
 var _ Environment = &Extension{}
@@ -185,6 +190,7 @@ func NewExtension(defs Definitions, cm extension.ControllerManager) (*Extension,
 		definitions:   defs,
 		registrations: registrations,
 		prepared:      map[string]*sync.SyncPoint{},
+		started:       map[string]*sync.SyncPoint{},
 
 		after:        after,
 		plain_groups: map[string]StartupGroup{},
@@ -224,7 +230,13 @@ func (this *Extension) Start(ctx context.Context) error {
 			this.Infof("found migrations: %s", this.ControllerManager().GetClusterIdMigration())
 		}
 	}
+	dynamic := Registrations{}
 	for _, def := range this.registrations {
+		if def.DynamicCluster() != "" {
+			this.Infof("controller %q is instantiated per dynamic cluster (%s)", def.Name(), def.DynamicCluster())
+			dynamic[def.Name()] = def
+			continue
+		}
 		lines := strings.Split(def.String(), "\n")
 		this.Infof("creating %s", lines[0])
 		for _, l := range lines[1:] {
@@ -247,6 +259,7 @@ func (this *Extension) Start(ctx context.Context) error {
 
 		this.controllers = append(this.controllers, cntr)
 		this.prepared[cntr.GetName()] = &sync.SyncPoint{}
+		this.started[cntr.GetName()] = &sync.SyncPoint{}
 	}
 
 	this.controllers, err = this.controllers.getOrder(this)
@@ -256,12 +269,18 @@ func (this *Extension) Start(ctx context.Context) error {
 
 	for _, cntr := range this.controllers {
 		def := this.registrations[cntr.GetName()]
+		var lease *leasestartupgroup
 		if def.RequireLease() {
 			cluster := cntr.GetCluster(def.LeaseClusterName())
-			this.getLeaseStartupGroup(cluster).Add(cntr)
+			g := this.getLeaseStartupGroup(cluster)
+			g.Add(cntr)
+			lease = g.(*leasestartupgroup)
 		} else {
 			this.getPlainStartupGroup(cntr.GetMainCluster()).Add(cntr)
 		}
+		check := cntr.readinessCheck(lease)
+		ready.RegisterCheck("controller/"+cntr.GetName(), check)
+		ready.RegisterStartupCheck("controller/"+cntr.GetName(), check)
 
 		err := this.checkController(cntr)
 		if err != nil {
@@ -269,11 +288,29 @@ func (this *Extension) Start(ctx context.Context) error {
 		}
 	}
 
+	if this.config.DebugEndpoints {
+		RegisterInspectionEndpoints()
+	}
+
+	if len(dynamic) > 0 {
+		this.dynamic, err = newDynamicControllers(this, dynamic)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = this.startGroups(this.plain_groups, this.lease_groups)
 	if err != nil {
 		return err
 	}
 
+	if this.dynamic != nil {
+		err = this.dynamic.Start()
+		if err != nil {
+			return err
+		}
+	}
+
 	ctxutil.WaitGroupRun(ctx, func() {
 		<-this.GetContext().Done()
 		this.Info("waiting for controllers to shutdown")
@@ -299,7 +336,7 @@ func (this *Extension) setupController(cntr *controller) error {
 		if after != nil {
 			if !after.IsReached() {
 				cntr.Infof("  setup of %q waiting for %q", cntr.GetName(), a)
-				if !after.Sync(this.GetContext()) {
+				if !after.Sync(cntr.GetEnvironment().GetContext()) {
 					return fmt.Errorf("setup aborted")
 				}
 				cntr.Infof("  controller %q is initialized now", a)
@@ -315,7 +352,7 @@ func (this *Extension) setupController(cntr *controller) error {
 	if err != nil {
 		return err
 	}
-	this.prepared[cntr.GetName()].Reach()
+	this.reach(cntr)
 	return nil
 }
 
@@ -323,28 +360,109 @@ func (this *Extension) setupController(cntr *controller) error {
 // all error conditions MUST also be checked
 // in checkController, so after a successful checkController
 // startController MUST not return an error.
+// The only exception are watches, whose caches cannot be synced within
+// the cache sync timeout of a cluster. If configured, such controllers are
+// skipped, otherwise the error is returned.
+// A controller waits for the start of the controllers it is ordered after.
+// If one of them has been skipped, it is skipped, too, because it must not
+// run without its predecessor.
 func (this *Extension) startController(cntr *controller) error {
-	cntr.Infof("starting controller")
-	err := cntr.prepare()
+	skipped, err := this.skippedPredecessor(cntr)
 	if err != nil {
 		return err
 	}
-	this.prepared[cntr.GetName()].Reach()
+	if skipped != "" {
+		this.skipController(cntr, fmt.Errorf("controller %q ordered before has been skipped", skipped))
+		return nil
+	}
+	cntr.Infof("starting controller")
+	err = cntr.prepare()
+	if err != nil {
+		return this.prepareFailed(cntr, err)
+	}
+	this.reach(cntr)
+	this.markStarted(cntr)
+
+	ctxutil.WaitGroupRunAndCancelOnExit(cntr.GetEnvironment().GetContext(), cntr.Run)
+	return nil
+}
 
-	ctxutil.WaitGroupRunAndCancelOnExit(this.GetContext(), cntr.Run)
+// prepareFailed handles an error of the preparation of a controller.
+// A controller, whose caches cannot be synced, is skipped if configured.
+func (this *Extension) prepareFailed(cntr *controller, err error) error {
+	var syncErr *resources.CacheSyncError
+	if !errors.As(err, &syncErr) {
+		return err
+	}
+	if !this.config.SkipUnsynced {
+		return fmt.Errorf("cannot start controller %s: %w", cntr.GetName(), err)
+	}
+	this.skipController(cntr, err)
 	return nil
 }
 
+// skipController skips a controller. Controllers ordered after it
+// are skipped when they are started.
+func (this *Extension) skipController(cntr *controller, err error) {
+	cntr.Errorf("skipping controller: %s", err)
+	cntr.skip(err)
+	this.markStarted(cntr)
+}
+
+// skippedPredecessor waits until the controllers a statically created
+// controller is ordered after have been started or skipped, and returns
+// the name of the first skipped one.
+func (this *Extension) skippedPredecessor(cntr *controller) (string, error) {
+	if cntr.member != "" {
+		return "", nil
+	}
+	for _, a := range this.after[cntr.GetName()] {
+		started := this.started[a]
+		pred := this.controllers.Get(a)
+		if started == nil || pred == nil {
+			continue
+		}
+		if !started.Sync(cntr.GetEnvironment().GetContext()) {
+			return "", fmt.Errorf("start aborted")
+		}
+		if _, err := pred.skipState(); err != nil {
+			return a, nil
+		}
+	}
+	return "", nil
+}
+
+// markStarted marks a statically created controller as started or skipped.
+func (this *Extension) markStarted(cntr *controller) {
+	if cntr.member == "" {
+		this.started[cntr.GetName()].Reach()
+	}
+}
+
+// reach marks a statically created controller as prepared.
+// Controller instances for dynamic clusters are not tracked.
+func (this *Extension) reach(cntr *controller) {
+	if cntr.member == "" {
+		this.prepared[cntr.GetName()].Reach()
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func (this *Extension) Enqueue(obj resources.Object) {
 	for _, c := range this.controllers {
 		_ = c.Enqueue(obj)
 	}
+	for _, c := range this.dynamic.Controllers() {
+		_ = c.Enqueue(obj)
+	}
 }
 
 func (this *Extension) EnqueueKey(key resources.ClusterObjectKey) {
 	for _, c := range this.controllers {
 		_ = c.EnqueueKey(key)
 	}
+	for _, c := range this.dynamic.Controllers() {
+		_ = c.EnqueueKey(key)
+	}
 }
@@ -0,0 +1,255 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/reconcile"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+	"github.com/gardener/controller-manager-library/pkg/resources/conditions"
+)
+
+// DEBUG_HISTORY_PATH is the path of the http endpoint
+// serving the reconcile history of all running controllers.
+const DEBUG_HISTORY_PATH = "/debug/controllers/history"
+
+// ReconcileRecord describes a single reconciler call for a workqueue key.
+type ReconcileRecord struct {
+	Key        string            `json:"key"`
+	Reconciler string            `json:"reconciler"`
+	Start      time.Time         `json:"start"`
+	Duration   string            `json:"duration"`
+	Outcome    reconcile.Outcome `json:"outcome"`
+	Error      string            `json:"error,omitempty"`
+	Reschedule string            `json:"reschedule,omitempty"`
+}
+
+// ControllerHistory describes the recorded reconcile history of a controller.
+type ControllerHistory struct {
+	Name       string            `json:"name"`
+	Member     string            `json:"member,omitempty"`
+	Records    []ReconcileRecord `json:"records"`
+	LastErrors []ReconcileRecord `json:"lastErrors,omitempty"`
+}
+
+// reconcileHistory is a bounded ring of reconcile records. Additionally
+// the last failed record is kept for the keys, whose last reconcilation
+// did not succeed. The number of those keys is limited by the size of
+// the ring, the oldest errors are dropped first, so that keys of deleted
+// objects never reconciled successfully don't accumulate.
+type reconcileHistory struct {
+	lock       sync.Mutex
+	records    []ReconcileRecord
+	next       int
+	full       bool
+	lastErrors map[string]ReconcileRecord
+}
+
+func newReconcileHistory(size int) *reconcileHistory {
+	if size <= 0 {
+		return nil
+	}
+	return &reconcileHistory{
+		records:    make([]ReconcileRecord, size),
+		lastErrors: map[string]ReconcileRecord{},
+	}
+}
+
+func (this *reconcileHistory) Add(r ReconcileRecord) {
+	if this == nil {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.records[this.next] = r
+	this.next = (this.next + 1) % len(this.records)
+	if this.next == 0 {
+		this.full = true
+	}
+	if r.Error != "" {
+		this.lastErrors[r.Key] = r
+		if len(this.lastErrors) > len(this.records) {
+			this.dropOldestError()
+		}
+	} else if r.Outcome == reconcile.OutcomeSucceeded {
+		delete(this.lastErrors, r.Key)
+	}
+}
+
+func (this *reconcileHistory) dropOldestError() {
+	oldest := ""
+	var start time.Time
+	for k, r := range this.lastErrors {
+		if oldest == "" || r.Start.Before(start) {
+			oldest = k
+			start = r.Start
+		}
+	}
+	delete(this.lastErrors, oldest)
+}
+
+// Records returns the matching records starting with the latest one.
+func (this *reconcileHistory) Records(match func(r *ReconcileRecord) bool, max int) []ReconcileRecord {
+	result := []ReconcileRecord{}
+	if this == nil {
+		return result
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	n := this.next
+	if this.full {
+		n = len(this.records)
+	}
+	for i := 1; i <= n; i++ {
+		if max >= 0 && len(result) >= max {
+			break
+		}
+		r := &this.records[(this.next-i+len(this.records))%len(this.records)]
+		if match == nil || match(r) {
+			result = append(result, *r)
+		}
+	}
+	return result
+}
+
+// LastErrors returns the last failed record for all keys
+// not reconciled successfully since.
+func (this *reconcileHistory) LastErrors(match func(r *ReconcileRecord) bool) []ReconcileRecord {
+	result := []ReconcileRecord{}
+	if this == nil {
+		return result
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	for _, r := range this.lastErrors {
+		if match == nil || match(&r) {
+			result = append(result, r)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Start.After(result[j].Start) })
+	return result
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func (this *controller) recordReconcile(key string, reconciler reconcile.Interface, start time.Time, status reconcile.Status) {
+	r := ReconcileRecord{
+		Key:        key,
+		Reconciler: this.reconcilerNames[reconciler],
+		Start:      start,
+		Duration:   time.Since(start).String(),
+		Outcome:    status.Outcome(),
+	}
+	if status.Error != nil {
+		r.Error = status.Error.Error()
+	}
+	if status.Interval >= 0 {
+		r.Reschedule = status.Interval.String()
+	}
+	this.history.Add(r)
+}
+
+// GetReconcileHistory returns the recorded reconcile calls for
+// the given object key, starting with the latest one.
+func (this *controller) GetReconcileHistory(key resources.ClusterObjectKey) []ReconcileRecord {
+	cluster := this.GetClusterById(key.Cluster())
+	if cluster == nil {
+		return nil
+	}
+	okey := EncodeObjectKey(cluster.GetName(), key.ObjectKey())
+	return this.history.Records(func(r *ReconcileRecord) bool { return r.Key == okey }, -1)
+}
+
+// updateReconcileCondition mirrors the outcome of the reconcilation
+// of an object into the configured reconcile condition of the object.
+func (this *controller) updateReconcileCondition(log logger.LogContext, obj resources.Object, status reconcile.Status) {
+	ctype := this.definition.ReconcileCondition()
+	if ctype == nil || obj == nil || obj.IsDeleting() {
+		return
+	}
+	_, err := obj.ModifyStatus(func(data resources.ObjectData) (bool, error) {
+		return setReconcileCondition(ctype, data, status)
+	})
+	if err != nil {
+		log.Warnf("cannot update reconcile condition %s: %s", ctype.Name(), err)
+	}
+}
+
+func setReconcileCondition(ctype *conditions.ConditionType, data resources.ObjectData, status reconcile.Status) (bool, error) {
+	cond := ctype.Get(data)
+	if cond == nil {
+		return false, fmt.Errorf("no conditions found for %T", data)
+	}
+	cond.ResetModified()
+	state := "True"
+	msg := ""
+	if !status.IsSucceeded() {
+		state = "False"
+	}
+	if status.Error != nil {
+		msg = status.Error.Error()
+	}
+	if err := cond.SetStatus(state); err != nil {
+		return false, err
+	}
+	if err := cond.SetReason(string(status.Outcome())); err != nil {
+		return false, err
+	}
+	if err := cond.SetMessage(msg); err != nil {
+		return false, err
+	}
+	return cond.IsModified(), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ReconcileHistories is a HTTP handler serving the reconcile history of all
+// running controllers. The query parameter `controller` restricts the result
+// to a dedicated controller, `key` to a dedicated workqueue key, `outcome` to
+// a dedicated outcome and `max` limits the number of records per controller
+// (default 100, -1 for all).
+func ReconcileHistories(w http.ResponseWriter, r *http.Request) {
+	max := 100
+	query := r.URL.Query()
+	if s := query.Get("max"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid max value %q: %s", s, err), http.StatusBadRequest)
+			return
+		}
+		max = v
+	}
+	name := query.Get("controller")
+	key := query.Get("key")
+	outcome := reconcile.Outcome(query.Get("outcome"))
+
+	match := func(r *ReconcileRecord) bool {
+		return (key == "" || key == r.Key) && (outcome == "" || outcome == r.Outcome)
+	}
+	result := []ControllerHistory{}
+	for _, c := range getRunningControllers() {
+		if name == "" || name == c.GetName() {
+			result = append(result, ControllerHistory{
+				Name:       c.GetName(),
+				Member:     c.member,
+				Records:    c.history.Records(match, max),
+				LastErrors: c.history.LastErrors(match),
+			})
+		}
+	}
+	writeJSON(w, result)
+}
@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/reconcile"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+)
+
+var _ = Describe("Reconcile history", func() {
+	start := time.Now()
+
+	record := func(i int, key string, err error) ReconcileRecord {
+		r := ReconcileRecord{Key: key, Start: start.Add(time.Duration(i) * time.Second), Outcome: reconcile.OutcomeSucceeded}
+		if err != nil {
+			r.Error = err.Error()
+			r.Outcome = reconcile.OutcomeFailed
+		}
+		return r
+	}
+	keys := func(list []ReconcileRecord) []string {
+		var result []string
+		for _, r := range list {
+			result = append(result, r.Key)
+		}
+		return result
+	}
+
+	It("is disabled for size 0", func() {
+		h := newReconcileHistory(0)
+		gomega.Expect(h).To(gomega.BeNil())
+		h.Add(record(0, "a", nil))
+		gomega.Expect(h.Records(nil, -1)).To(gomega.BeEmpty())
+		gomega.Expect(h.LastErrors(nil)).To(gomega.BeEmpty())
+	})
+
+	It("returns the records starting with the latest one", func() {
+		h := newReconcileHistory(3)
+		h.Add(record(0, "a", nil))
+		h.Add(record(1, "b", nil))
+		gomega.Expect(keys(h.Records(nil, -1))).To(gomega.Equal([]string{"b", "a"}))
+	})
+
+	It("wraps around", func() {
+		h := newReconcileHistory(3)
+		for i, k := range []string{"a", "b", "c", "d", "e"} {
+			h.Add(record(i, k, nil))
+		}
+		gomega.Expect(keys(h.Records(nil, -1))).To(gomega.Equal([]string{"e", "d", "c"}))
+	})
+
+	It("wraps around exactly at the size", func() {
+		h := newReconcileHistory(3)
+		for i, k := range []string{"a", "b", "c"} {
+			h.Add(record(i, k, nil))
+		}
+		gomega.Expect(keys(h.Records(nil, -1))).To(gomega.Equal([]string{"c", "b", "a"}))
+	})
+
+	It("filters and limits records", func() {
+		h := newReconcileHistory(10)
+		for i, k := range []string{"a", "b", "a", "b", "a"} {
+			h.Add(record(i, k, nil))
+		}
+		match := func(r *ReconcileRecord) bool { return r.Key == "a" }
+		gomega.Expect(h.Records(match, -1)).To(gomega.HaveLen(3))
+		limited := h.Records(match, 2)
+		gomega.Expect(limited).To(gomega.HaveLen(2))
+		gomega.Expect(limited[0].Start).To(gomega.Equal(start.Add(4 * time.Second)))
+		gomega.Expect(h.Records(nil, 0)).To(gomega.BeEmpty())
+	})
+
+	It("keeps the last error per key until it succeeds", func() {
+		h := newReconcileHistory(2)
+		h.Add(record(0, "a", fmt.Errorf("first")))
+		h.Add(record(1, "b", fmt.Errorf("other")))
+		h.Add(record(2, "a", fmt.Errorf("second")))
+		// errors survive the ring
+		h.Add(record(3, "c", nil))
+		h.Add(record(4, "c", nil))
+
+		errors := h.LastErrors(nil)
+		gomega.Expect(keys(errors)).To(gomega.Equal([]string{"a", "b"}))
+		gomega.Expect(errors[0].Error).To(gomega.Equal("second"))
+
+		gomega.Expect(keys(h.LastErrors(func(r *ReconcileRecord) bool { return r.Key == "b" }))).To(gomega.Equal([]string{"b"}))
+
+		h.Add(record(5, "a", nil))
+		gomega.Expect(keys(h.LastErrors(nil))).To(gomega.Equal([]string{"b"}))
+	})
+
+	It("limits the number of kept errors to the history size", func() {
+		h := newReconcileHistory(2)
+		h.Add(record(0, "a", fmt.Errorf("deleted")))
+		h.Add(record(1, "b", fmt.Errorf("deleted")))
+		h.Add(record(2, "c", fmt.Errorf("failed")))
+		gomega.Expect(keys(h.LastErrors(nil))).To(gomega.Equal([]string{"c", "b"}))
+
+		h.Add(record(3, "b", fmt.Errorf("again")))
+		h.Add(record(4, "d", fmt.Errorf("failed")))
+		gomega.Expect(keys(h.LastErrors(nil))).To(gomega.Equal([]string{"d", "b"}))
+	})
+})
@@ -0,0 +1,219 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gardener/controller-manager-library/pkg/server"
+)
+
+// DEBUG_WORKQUEUES_PATH is the path of the http endpoint
+// serving the workqueue state of all running controllers.
+const DEBUG_WORKQUEUES_PATH = "/debug/controllers/workqueues"
+
+const defaultMaxRequeued = 10
+
+// WorkerState describes the processing state of a worker of a pool.
+type WorkerState struct {
+	Number   int        `json:"number"`
+	Key      string     `json:"key,omitempty"`
+	Start    *time.Time `json:"start,omitempty"`
+	Duration string     `json:"duration,omitempty"`
+}
+
+// RequeueState describes the rate limiting state of a requeued key.
+type RequeueState struct {
+	Key         string    `json:"key"`
+	Failures    int       `json:"failures"`
+	Backoff     string    `json:"backoff"`
+	LastFailure time.Time `json:"lastFailure"`
+	LastError   string    `json:"lastError,omitempty"`
+}
+
+// PoolState describes the state of a worker pool and its workqueue.
+type PoolState struct {
+	Name        string         `json:"name"`
+	Size        int            `json:"size"`
+	Period      string         `json:"period"`
+	QueueLength int            `json:"queueLength"`
+	Workers     []WorkerState  `json:"workers"`
+	Requeued    []RequeueState `json:"requeued,omitempty"`
+}
+
+// ControllerState describes the state of the worker pools of a controller.
+type ControllerState struct {
+	Name   string      `json:"name"`
+	Member string      `json:"member,omitempty"`
+	Pools  []PoolState `json:"pools"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type workerState struct {
+	key   string
+	start time.Time
+}
+
+func (p *pool) workerStarted(number int, key string) {
+	p.wlock.Lock()
+	defer p.wlock.Unlock()
+	p.workers[number] = &workerState{key: key, start: time.Now()}
+}
+
+func (p *pool) workerDone(number int) {
+	p.wlock.Lock()
+	defer p.wlock.Unlock()
+	delete(p.workers, number)
+}
+
+// State returns the actual state of the pool including
+// the max most requeued keys.
+func (p *pool) State(max int) PoolState {
+	state := PoolState{
+		Name:        p.name,
+		Period:      p.period.String(),
+		QueueLength: p.workqueue.Len(),
+		Workers:     []WorkerState{},
+	}
+
+	now := time.Now()
+	p.wlock.Lock()
+	state.Size = p.size
+	for _, r := range p.running {
+		ws := WorkerState{Number: r.id}
+		if w := p.workers[r.id]; w != nil {
+			start := w.start
+			ws.Key = w.key
+			ws.Start = &start
+			ws.Duration = now.Sub(start).String()
+		}
+		state.Workers = append(state.Workers, ws)
+	}
+	p.wlock.Unlock()
+
+	failures := p.limiter.Failures()
+	sort.Slice(failures, func(i, j int) bool {
+		if failures[i].failures != failures[j].failures {
+			return failures[i].failures > failures[j].failures
+		}
+		return failures[i].lastFailure.After(failures[j].lastFailure)
+	})
+	if max >= 0 && len(failures) > max {
+		failures = failures[:max]
+	}
+	for _, f := range failures {
+		state.Requeued = append(state.Requeued, RequeueState{
+			Key:         fmt.Sprintf("%s", f.key),
+			Failures:    f.failures,
+			Backoff:     f.backoff.String(),
+			LastFailure: f.lastFailure,
+			LastError:   f.lastError,
+		})
+	}
+	return state
+}
+
+// State returns the actual state of the worker pools of the controller.
+func (this *controller) State(max int) ControllerState {
+	state := ControllerState{
+		Name:   this.GetName(),
+		Member: this.member,
+		Pools:  []PoolState{},
+	}
+	names := make([]string, 0, len(this.pools))
+	for n := range this.pools {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		state.Pools = append(state.Pools, this.pools[n].State(max))
+	}
+	return state
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// running controllers
+
+var (
+	runningLock        sync.Mutex
+	runningControllers = map[*controller]struct{}{}
+	registerInspection sync.Once
+)
+
+func addRunningController(c *controller) {
+	runningLock.Lock()
+	defer runningLock.Unlock()
+	runningControllers[c] = struct{}{}
+}
+
+func removeRunningController(c *controller) {
+	runningLock.Lock()
+	defer runningLock.Unlock()
+	delete(runningControllers, c)
+}
+
+func getRunningControllers() []*controller {
+	runningLock.Lock()
+	defer runningLock.Unlock()
+
+	result := make([]*controller, 0, len(runningControllers))
+	for c := range runningControllers {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].instanceName() < result[j].instanceName() })
+	return result
+}
+
+// RegisterInspectionEndpoints registers the debug endpoints
+// for the running controllers at the default http server.
+func RegisterInspectionEndpoints() {
+	registerInspection.Do(func() {
+		server.Register(DEBUG_WORKQUEUES_PATH, WorkqueueStates)
+		server.Register(DEBUG_HISTORY_PATH, ReconcileHistories)
+	})
+}
+
+// WorkqueueStates is a HTTP handler serving the workqueue state of all running
+// controllers. The query parameter `controller` restricts the result to a
+// dedicated controller and `max` limits the number of reported requeued keys
+// per pool (default 10, -1 for all).
+func WorkqueueStates(w http.ResponseWriter, r *http.Request) {
+	max := defaultMaxRequeued
+	if s := r.URL.Query().Get("max"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid max value %q: %s", s, err), http.StatusBadRequest)
+			return
+		}
+		max = v
+	}
+	name := r.URL.Query().Get("controller")
+
+	result := []ControllerState{}
+	for _, c := range getRunningControllers() {
+		if name == "" || name == c.GetName() {
+			result = append(result, c.State(max))
+		}
+	}
+	writeJSON(w, result)
+}
+
+func writeJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -9,6 +9,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -23,6 +24,7 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
 	"github.com/gardener/controller-manager-library/pkg/resources/apiextensions"
+	"github.com/gardener/controller-manager-library/pkg/resources/conditions"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 )
 
@@ -76,6 +78,8 @@ type Interface interface {
 	GetObject(key resources.ClusterObjectKey) (resources.Object, error)
 	GetCachedObject(key resources.ClusterObjectKey) (resources.Object, error)
 
+	GetReconcileHistory(key resources.ClusterObjectKey) []ReconcileRecord
+
 	WithLease(name string, regain bool, action func(ctx context.Context), cnames ...string) error
 	HasLeaseRequest(name string, cnames ...string) bool
 	IsLeaseActive(name string, cnames ...string) bool
@@ -186,6 +190,25 @@ func (this CrossClusterRefs) Add(ref ForeignClusterRefs) {
 	}
 }
 
+// Without returns the references not involving the given cluster.
+func (this CrossClusterRefs) Without(name string) CrossClusterRefs {
+	if this == nil {
+		return nil
+	}
+	result := CrossClusterRefs{}
+	for _, r := range this {
+		if r.From() == name {
+			continue
+		}
+		to := r.To().Copy()
+		to.Remove(name)
+		if len(to) > 0 {
+			result.Add(NewForeignClusterRefs(r.From()).AddSet(to))
+		}
+	}
+	return result
+}
+
 func (this CrossClusterRefs) Targets() utils.StringSet {
 	targets := utils.StringSet{}
 	for _, r := range this {
@@ -225,6 +248,8 @@ type Definition interface {
 
 	// Create(Object) (Reconciler, error)
 	Reconcilers() map[string]ReconcilerType
+	// ReconcilerTypes provides the object types typed reconcilers are restricted to
+	ReconcilerTypes() map[string]reflect.Type
 	Syncers() map[string]SyncerDefinition
 	MainResource(WatchContext) *WatchResourceDef
 	MainWatchResource() WatchResource
@@ -238,7 +263,13 @@ type Definition interface {
 	CustomResourceDefinitions() map[string][]*apiextensions.CustomResourceDefinitionVersions
 	RequireLease() bool
 	LeaseClusterName() string
+	// DynamicCluster provides the logical name of the cluster the controller
+	// is instantiated for per member of the dynamic cluster set (optional)
+	DynamicCluster() string
 	FinalizerName() string
+	// ReconcileCondition provides the condition type used to reflect the
+	// reconcile outcome for objects of the main resource (optional)
+	ReconcileCondition() *conditions.ConditionType
 	ActivateExplicitly() bool
 	DeactivateOnCreationErrorCheck() func(err error) bool
 
@@ -9,15 +9,56 @@ package controller
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/lease"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
 	"github.com/gardener/controller-manager-library/pkg/ctxutil"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
 
 	"k8s.io/client-go/tools/leaderelection"
 )
 
 type leasestartupgroup struct {
 	startupgroup
+
+	lock  sync.Mutex
+	state string
+	since time.Time
+}
+
+const (
+	leaseRequested = "waiting for lease"
+	leaseLeading   = "leading"
+	leaseLost      = "lease lost"
+	leaseOmitted   = "lease omitted"
+)
+
+func (this *leasestartupgroup) setState(state string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.state = state
+	this.since = time.Now()
+}
+
+// Check reports the lease state of the group. Instances waiting
+// for the lease are ready, because they are required as standby.
+func (this *leasestartupgroup) Check() healthz.Status {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	reason := fmt.Sprintf("%s since %s", this.state, this.since.Format(time.RFC3339))
+	if this.state == leaseLost {
+		return healthz.NewStatus("", reason)
+	}
+	return healthz.Status{Healthy: true, Reason: reason}
+}
+
+func (this *leasestartupgroup) leading() bool {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return this.state == leaseLeading || this.state == leaseOmitted
 }
 
 func (this *leasestartupgroup) Startup() error {
@@ -51,7 +92,9 @@ func (this *leasestartupgroup) Startup() error {
 		}
 	}
 
+	ready.RegisterCheck("lease/"+this.cluster.GetName(), this)
 	if leasecfg.OmitLease {
+		this.setState(leaseOmitted)
 		this.extension.Infof("omitting lease %q for cluster %s in namespace %q",
 			this.extension.Name(), msg, this.extension.Namespace())
 		ctxutil.WaitGroupRun(this.extension.GetContext(), runit)
@@ -66,6 +109,7 @@ func (this *leasestartupgroup) Startup() error {
 
 		leaderElectionConfig.Callbacks = leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
+				this.setState(leaseLeading)
 				go func() {
 					<-ctx.Done()
 					this.extension.Infof("lease group %s stopped -> shutdown controller manager", this.cluster.GetName())
@@ -74,6 +118,7 @@ func (this *leasestartupgroup) Startup() error {
 				runit()
 			},
 			OnStoppedLeading: func() {
+				this.setState(leaseLost)
 				this.extension.Infof("Lost leadership, cleaning up %s.", msg)
 			},
 		}
@@ -81,6 +126,7 @@ func (this *leasestartupgroup) Startup() error {
 		if err != nil {
 			return fmt.Errorf("couldn't create leader elector: %v", err)
 		}
+		this.setState(leaseRequested)
 		ctxutil.WaitGroupRun(this.extension.GetContext(), func() { leaderElector.Run(this.extension.GetContext()) })
 	}
 
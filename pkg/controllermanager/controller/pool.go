@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/reconcile"
@@ -91,18 +92,35 @@ type pool struct {
 	period      time.Duration
 	key         string
 	workqueue   workqueue.RateLimitingInterface
+	limiter     *trackingRateLimiter
 	reconcilers *reconcilerMapping
+
+	wlock   sync.Mutex
+	workers map[int]*workerState
+	running []runningWorker
+	// nextWorker is the id of the next started worker. Ids are never
+	// reused, so that a stopping worker cannot affect the state
+	// of a worker started later on by a resize.
+	nextWorker int
+}
+
+type runningWorker struct {
+	id  int
+	ctx context.Context
 }
 
 func NewPool(controller *controller, name string, size int, period time.Duration) *pool {
+	limiter := newTrackingRateLimiter(workqueue.DefaultControllerRateLimiter())
 	pool := &pool{
 		name:        name,
 		controller:  controller,
 		size:        size,
 		period:      period,
-		key:         fmt.Sprintf("controller:%s/pool:%s", controller.GetName(), name),
-		workqueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
+		key:         fmt.Sprintf("controller:%s/pool:%s", controller.instanceName(), name),
+		workqueue:   workqueue.NewNamedRateLimitingQueue(limiter, name),
+		limiter:     limiter,
 		reconcilers: newReconcilerMapping(),
+		workers:     map[int]*workerState{},
 	}
 	pool.ctx, pool.LogContext = logger.WithLogger(
 		ctxutil.WaitGroupContext(
@@ -162,9 +180,13 @@ func (p *pool) Run() {
 	p.workqueue.AddAfter(tickCmd, period)
 
 	healthz.Start(p.Key(), period)
+	p.wlock.Lock()
 	for i := 0; i < p.size; i++ {
-		p.startWorker(i)
+		p.running = append(p.running, p.startWorker())
 	}
+	p.wlock.Unlock()
+	p.controller.options.addPool(p)
+	defer p.controller.options.removePool(p)
 
 	<-p.ctx.Done()
 	p.workqueue.ShutDown()
@@ -173,8 +195,37 @@ func (p *pool) Run() {
 	healthz.End(p.Key())
 }
 
-func (p *pool) startWorker(number int) {
-	ctxutil.WaitGroupRunUntilCancelled(p.ctx, func() { newWorker(p, number).Run() })
+// startWorker starts a worker with a new id.
+// It must be called with the worker lock held.
+func (p *pool) startWorker() runningWorker {
+	id := p.nextWorker
+	p.nextWorker++
+	ctx := ctxutil.CancelContext(p.ctx)
+	ctxutil.WaitGroupRunUntilCancelled(ctx, func() { newWorker(p, ctx, id).Run() })
+	return runningWorker{id: id, ctx: ctx}
+}
+
+// resize adapts the number of workers of a running pool. Superfluous
+// workers stop after finishing their actual work item.
+func (p *pool) resize(size int) {
+	if size < 1 {
+		p.Warnf("invalid pool size %d -> ignored", size)
+		return
+	}
+	p.wlock.Lock()
+	defer p.wlock.Unlock()
+	if size == p.size {
+		return
+	}
+	p.Infof("changing pool size from %d to %d", p.size, size)
+	for i := len(p.running); i < size; i++ {
+		p.running = append(p.running, p.startWorker())
+	}
+	for i := size; i < len(p.running); i++ {
+		ctxutil.Cancel(p.running[i].ctx)
+	}
+	p.running = p.running[:size]
+	p.size = size
 }
 func (p *pool) EnqueueCommand(cmd string) {
 	p.enqueueCommand(cmd, p.workqueue.Add)
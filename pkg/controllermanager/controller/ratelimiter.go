@@ -0,0 +1,92 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// failureInfo keeps the requeue state of a workqueue key.
+type failureInfo struct {
+	key         interface{}
+	failures    int
+	backoff     time.Duration
+	lastFailure time.Time
+	lastError   string
+}
+
+// trackingRateLimiter wraps a workqueue rate limiter to keep track of
+// the requeued keys, their failure count and the last error for
+// inspection purposes.
+type trackingRateLimiter struct {
+	workqueue.RateLimiter
+	lock     sync.Mutex
+	failures map[interface{}]*failureInfo
+}
+
+var _ workqueue.RateLimiter = &trackingRateLimiter{}
+
+func newTrackingRateLimiter(limiter workqueue.RateLimiter) *trackingRateLimiter {
+	return &trackingRateLimiter{
+		RateLimiter: limiter,
+		failures:    map[interface{}]*failureInfo{},
+	}
+}
+
+func (this *trackingRateLimiter) When(item interface{}) time.Duration {
+	d := this.RateLimiter.When(item)
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	info := this.assure(item)
+	info.failures++
+	info.backoff = d
+	info.lastFailure = time.Now()
+	return d
+}
+
+func (this *trackingRateLimiter) Forget(item interface{}) {
+	this.RateLimiter.Forget(item)
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	delete(this.failures, item)
+}
+
+// SetError records the last error observed for a key.
+func (this *trackingRateLimiter) SetError(item interface{}, err error) {
+	if err == nil {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.assure(item).lastError = err.Error()
+}
+
+func (this *trackingRateLimiter) assure(item interface{}) *failureInfo {
+	info := this.failures[item]
+	if info == nil {
+		info = &failureInfo{key: item}
+		this.failures[item] = info
+	}
+	return info
+}
+
+// Failures returns a snapshot of the failure state of all tracked keys.
+func (this *trackingRateLimiter) Failures() []failureInfo {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	result := make([]failureInfo, 0, len(this.failures))
+	for _, info := range this.failures {
+		result = append(result, *info)
+	}
+	return result
+}
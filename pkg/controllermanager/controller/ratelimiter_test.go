@@ -0,0 +1,142 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	"github.com/gardener/controller-manager-library/pkg/ctxutil"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+var _ = Describe("Tracking rate limiter", func() {
+	var limiter *trackingRateLimiter
+
+	BeforeEach(func() {
+		limiter = newTrackingRateLimiter(workqueue.NewTypedItemExponentialFailureRateLimiter[any](time.Millisecond, time.Second))
+	})
+
+	It("tracks failures and backoff", func() {
+		gomega.Expect(limiter.When("a")).To(gomega.Equal(time.Millisecond))
+		gomega.Expect(limiter.When("a")).To(gomega.Equal(2 * time.Millisecond))
+		limiter.SetError("a", fmt.Errorf("failed"))
+
+		failures := limiter.Failures()
+		gomega.Expect(failures).To(gomega.HaveLen(1))
+		gomega.Expect(failures[0].key).To(gomega.Equal("a"))
+		gomega.Expect(failures[0].failures).To(gomega.Equal(2))
+		gomega.Expect(failures[0].backoff).To(gomega.Equal(2 * time.Millisecond))
+		gomega.Expect(failures[0].lastError).To(gomega.Equal("failed"))
+		gomega.Expect(failures[0].lastFailure).NotTo(gomega.BeZero())
+	})
+
+	It("ignores nil errors", func() {
+		limiter.SetError("a", nil)
+		gomega.Expect(limiter.Failures()).To(gomega.BeEmpty())
+	})
+
+	It("drops forgotten keys", func() {
+		limiter.When("a")
+		limiter.When("b")
+		limiter.Forget("a")
+		failures := limiter.Failures()
+		gomega.Expect(failures).To(gomega.HaveLen(1))
+		gomega.Expect(failures[0].key).To(gomega.Equal("b"))
+		gomega.Expect(limiter.NumRequeues("a")).To(gomega.Equal(0))
+	})
+})
+
+var _ = Describe("Pool state", func() {
+	var p *pool
+
+	BeforeEach(func() {
+		limiter := newTrackingRateLimiter(workqueue.NewTypedItemExponentialFailureRateLimiter[any](time.Millisecond, time.Second))
+		p = &pool{
+			name:      "test",
+			size:      2,
+			period:    time.Minute,
+			workqueue: workqueue.NewNamedRateLimitingQueue(limiter, "test"),
+			limiter:   limiter,
+			workers:   map[int]*workerState{},
+			running:   []runningWorker{{id: 0}, {id: 1}},
+		}
+	})
+	AfterEach(func() {
+		p.workqueue.ShutDown()
+	})
+
+	It("reports workers and queue length", func() {
+		p.workqueue.Add("queued")
+		p.workerStarted(1, "busy")
+
+		state := p.State(-1)
+		gomega.Expect(state.Name).To(gomega.Equal("test"))
+		gomega.Expect(state.Size).To(gomega.Equal(2))
+		gomega.Expect(state.Period).To(gomega.Equal("1m0s"))
+		gomega.Expect(state.QueueLength).To(gomega.Equal(1))
+		gomega.Expect(state.Workers).To(gomega.HaveLen(2))
+		gomega.Expect(state.Workers[0].Key).To(gomega.BeEmpty())
+		gomega.Expect(state.Workers[1].Key).To(gomega.Equal("busy"))
+		gomega.Expect(state.Workers[1].Start).NotTo(gomega.BeNil())
+
+		p.workerDone(1)
+		gomega.Expect(p.State(-1).Workers[1].Key).To(gomega.BeEmpty())
+	})
+
+	It("reports the most requeued keys first", func() {
+		p.limiter.When("once")
+		p.limiter.When("twice")
+		p.limiter.When("twice")
+		p.limiter.SetError("twice", fmt.Errorf("failed"))
+
+		state := p.State(-1)
+		gomega.Expect(state.Requeued).To(gomega.HaveLen(2))
+		gomega.Expect(state.Requeued[0].Key).To(gomega.Equal("twice"))
+		gomega.Expect(state.Requeued[0].Failures).To(gomega.Equal(2))
+		gomega.Expect(state.Requeued[0].LastError).To(gomega.Equal("failed"))
+		gomega.Expect(state.Requeued[1].Key).To(gomega.Equal("once"))
+
+		gomega.Expect(p.State(1).Requeued).To(gomega.HaveLen(1))
+	})
+
+	It("uses new worker ids after shrinking and growing the pool", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		p.ctx = ctxutil.WaitGroupContext(ctx, "pool test")
+		p.LogContext = logger.New()
+		p.running = nil
+		p.workqueue.ShutDown()
+
+		p.wlock.Lock()
+		for i := 0; i < p.size; i++ {
+			p.running = append(p.running, p.startWorker())
+		}
+		p.wlock.Unlock()
+
+		p.resize(1)
+		p.resize(3)
+		ids := []int{}
+		for _, w := range p.State(-1).Workers {
+			ids = append(ids, w.Number)
+		}
+		gomega.Expect(ids).To(gomega.Equal([]int{0, 2, 3}))
+
+		// a stopped worker finishing its work item does not
+		// reset the state of a worker started later on
+		p.workerStarted(2, "new")
+		p.workerDone(1)
+		gomega.Expect(p.State(-1).Workers[1].Key).To(gomega.Equal("new"))
+	})
+})
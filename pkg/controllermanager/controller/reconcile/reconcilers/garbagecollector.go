@@ -0,0 +1,307 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package reconcilers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/reconcile"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+////////////////////////////////////////////////////////////////////////////////
+// Garbage collector for objects owned by objects of foreign clusters
+////////////////////////////////////////////////////////////////////////////////
+
+// GC_REPORT_COMMAND is the command logging the orphans
+// found by a garbage collector reconciler.
+const GC_REPORT_COMMAND = "gc-report"
+
+const (
+	DEFAULT_GC_GRACE_PERIOD     = 5 * time.Minute
+	DEFAULT_GC_RECHECK_INTERVAL = 30 * time.Minute
+)
+
+// GarbageCollectorSpec describes the behaviour of a garbage collector.
+type GarbageCollectorSpec struct {
+	// GracePeriod is the time an object must be orphaned before it is deleted.
+	GracePeriod time.Duration
+	// RecheckInterval is the interval used to recheck the owners of an
+	// object, if owner deletions are not watched.
+	RecheckInterval time.Duration
+	// DryRun only reports orphaned objects instead of deleting them.
+	DryRun bool
+}
+
+// OrphanReport describes an object found without existing owners.
+type OrphanReport struct {
+	Key     resources.ClusterObjectKey
+	Owners  resources.ClusterObjectKeySet
+	Since   time.Time
+	Deleted bool
+}
+
+func (this OrphanReport) String() string {
+	state := "orphaned"
+	if this.Deleted {
+		state = "deleted"
+	}
+	return fmt.Sprintf("%s: %s since %s (owners %s)", this.Key, state, this.Since.Format(time.RFC3339), this.Owners)
+}
+
+// GarbageCollector is a reconciler deleting objects of a cluster whose owners
+// maintained by the owner annotation (used for owners of foreign clusters
+// or namespaces) do not exist anymore. Owners are resolved using the
+// clusters of the controller, considering the cluster id and group kind
+// migrations of the controller manager. Objects with owners on clusters
+// not known by the controller are never deleted, as well as objects still
+// having kubernetes owner references.
+type GarbageCollector struct {
+	ReconcilerSupport
+	spec        GarbageCollectorSpec
+	clusterId   string
+	dependents  resources.GroupKindSet
+	migration   resources.ClusterIdMigration
+	gkMigration resources.GroupKindMigration
+	usages      *SimpleUsageCache
+
+	lock    sync.Mutex
+	orphans map[resources.ClusterObjectKey]*OrphanReport
+}
+
+var _ reconcile.Interface = &GarbageCollector{}
+
+// Report returns the actually known orphans.
+func (this *GarbageCollector) Report() []OrphanReport {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	result := make([]OrphanReport, 0, len(this.orphans))
+	for _, o := range this.orphans {
+		result = append(result, *o)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key.String() < result[j].Key.String() })
+	return result
+}
+
+func (this *GarbageCollector) Command(logger logger.LogContext, cmd string) reconcile.Status {
+	if cmd != GC_REPORT_COMMAND {
+		return this.ReconcilerSupport.Command(logger, cmd)
+	}
+	report := this.Report()
+	logger.Infof("found %d orphaned object(s)", len(report))
+	for _, o := range report {
+		logger.Infof("  %s", o)
+	}
+	return reconcile.Succeeded(logger)
+}
+
+func (this *GarbageCollector) isDependent(key resources.ClusterObjectKey) bool {
+	return key.Cluster() == this.clusterId && this.dependents.Contains(key.GroupKind())
+}
+
+func (this *GarbageCollector) Reconcile(logger logger.LogContext, obj resources.Object) reconcile.Status {
+	key := obj.ClusterKey()
+	if !this.isDependent(key) || obj.IsDeleting() {
+		return reconcile.Succeeded(logger)
+	}
+
+	owners := this.annotatedOwners(obj)
+	this.usages.UpdateUsesFor(key, owners)
+	if len(owners) == 0 {
+		this.forget(key)
+		return reconcile.Succeeded(logger)
+	}
+
+	for o := range owners {
+		found, err := this.ownerExists(o)
+		if err != nil {
+			return reconcile.Delay(logger, fmt.Errorf("cannot check owner %s: %s", o, err))
+		}
+		if found {
+			this.forget(key)
+			return this.recheck(logger)
+		}
+	}
+	if len(obj.GetOwnerReferences()) > 0 {
+		// still owned by local objects, left to the kubernetes garbage collector
+		this.forget(key)
+		return this.recheck(logger)
+	}
+
+	orphan := this.orphan(key, owners)
+	if remaining := this.spec.GracePeriod - time.Since(orphan.Since); remaining > 0 {
+		logger.Infof("owners %s not found, delete after grace period (%s)", owners, remaining)
+		return reconcile.RescheduleAfter(logger, remaining)
+	}
+	if this.spec.DryRun {
+		logger.Infof("owners %s not found, would delete orphaned object (dry run)", owners)
+		return this.recheck(logger)
+	}
+	logger.Infof("owners %s not found, deleting orphaned object", owners)
+	if err := obj.Delete(); err != nil && !errors.IsNotFound(err) {
+		return reconcile.Delay(logger, fmt.Errorf("cannot delete orphaned object: %s", err))
+	}
+	this.lock.Lock()
+	orphan.Deleted = true
+	this.lock.Unlock()
+	return reconcile.Succeeded(logger)
+}
+
+func (this *GarbageCollector) Deleted(logger logger.LogContext, key resources.ClusterObjectKey) reconcile.Status {
+	if this.isDependent(key) {
+		this.forget(key)
+		this.usages.UpdateUsesFor(key, nil)
+	}
+	if err := this.usages.ExecuteActionForUsersOf(logger, "owner deleted -> trigger", this.controller, key, EnqueueAction); err != nil {
+		return reconcile.Failed(logger, err)
+	}
+	return reconcile.Succeeded(logger)
+}
+
+func (this *GarbageCollector) recheck(logger logger.LogContext) reconcile.Status {
+	if this.spec.RecheckInterval > 0 {
+		return reconcile.RescheduleAfter(logger, this.spec.RecheckInterval)
+	}
+	return reconcile.Succeeded(logger)
+}
+
+// annotatedOwners returns the migrated owners maintained by the owner annotation.
+func (this *GarbageCollector) annotatedOwners(obj resources.Object) resources.ClusterObjectKeySet {
+	owners := resources.ClusterObjectKeySet{}
+	for _, r := range resources.GetAnnotatedOwners(obj.Data()) {
+		o, err := resources.ParseClusterObjectKey(obj.GetCluster().GetId(), r)
+		if err != nil {
+			continue
+		}
+		if this.migration != nil {
+			if id := this.migration.RequireMigration(o.Cluster()); id != "" {
+				o = o.ChangeCluster(id)
+			}
+		}
+		if this.gkMigration != nil {
+			if gk := this.gkMigration.RequireMigration(o.GroupKind()); gk != nil {
+				o = o.ChangeGroupKind(*gk)
+			}
+		}
+		owners.Add(o)
+	}
+	return owners
+}
+
+// ownerExists checks the existence of an owner. Owners on unknown clusters
+// or of unknown resources are always considered to exist. A missing owner in
+// the cache is confirmed by a direct lookup to avoid decisions on stale
+// cache content.
+func (this *GarbageCollector) ownerExists(key resources.ClusterObjectKey) (bool, error) {
+	c := this.controller.GetClusterById(key.Cluster())
+	if c == nil {
+		return true, nil
+	}
+	if _, err := c.Resources().GetByGK(key.GroupKind()); err != nil {
+		return true, nil
+	}
+	_, err := c.GetCachedObject(key)
+	if err == nil {
+		return true, nil
+	}
+	if !errors.IsNotFound(err) {
+		return false, err
+	}
+	_, err = c.GetObject(key)
+	if err == nil {
+		return true, nil
+	}
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (this *GarbageCollector) orphan(key resources.ClusterObjectKey, owners resources.ClusterObjectKeySet) *OrphanReport {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	o := this.orphans[key]
+	if o == nil {
+		o = &OrphanReport{Key: key, Since: time.Now()}
+		this.orphans[key] = o
+	}
+	o.Owners = owners
+	return o
+}
+
+func (this *GarbageCollector) forget(key resources.ClusterObjectKey) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	delete(this.orphans, key)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// GarbageCollectorForGKs configures a garbage collector reconciler with the given
+// name for objects of the given group kinds on the given cluster.
+// Owner deletions are observed by watching the owner resources configured with
+// GarbageCollectorOwners. Otherwise owners are rechecked periodically.
+// The command GC_REPORT_COMMAND is registered for the reconciler to log
+// the actually known orphans.
+func GarbageCollectorForGKs(name string, cluster string, spec GarbageCollectorSpec, gks ...schema.GroupKind) controller.ConfigurationModifier {
+	return func(c controller.Configuration) controller.Configuration {
+		if c.Definition().Reconcilers()[name] == nil {
+			c = c.Reconciler(CreateGarbageCollectorTypeFor(cluster, spec, gks...), name).
+				ReconcilerCommands(name, GC_REPORT_COMMAND)
+		}
+		return c.Cluster(cluster).ReconcilerWatchesByGK(name, gks...)
+	}
+}
+
+// GarbageCollectorOwners adds watches for owner resources of the given
+// cluster to the garbage collector reconciler with the given name, to
+// trigger the dependent objects when an owner is deleted.
+func GarbageCollectorOwners(name string, cluster string, gks ...schema.GroupKind) controller.ConfigurationModifier {
+	return func(c controller.Configuration) controller.Configuration {
+		return c.Cluster(cluster).ReconcilerWatchesByGK(name, gks...)
+	}
+}
+
+// CreateGarbageCollectorTypeFor creates a reconciler type for a garbage collector
+// for objects of the given group kinds on the given cluster. Owner resources
+// watched by the reconciler are used to trigger the dependents on deletion.
+func CreateGarbageCollectorTypeFor(clusterName string, spec GarbageCollectorSpec, gks ...schema.GroupKind) controller.ReconcilerType {
+	return func(c controller.Interface) (reconcile.Interface, error) {
+		cluster := c.GetCluster(clusterName)
+		if cluster == nil {
+			return nil, fmt.Errorf("cluster %s not found", clusterName)
+		}
+		if spec.GracePeriod <= 0 {
+			spec.GracePeriod = DEFAULT_GC_GRACE_PERIOD
+		}
+		if spec.RecheckInterval == 0 {
+			spec.RecheckInterval = DEFAULT_GC_RECHECK_INTERVAL
+		}
+		if spec.DryRun {
+			c.Infof("garbage collection for %s on cluster %s in dry run mode", resources.NewGroupKindSet(gks...), clusterName)
+		}
+		return &GarbageCollector{
+			ReconcilerSupport: NewReconcilerSupport(c),
+			spec:              spec,
+			clusterId:         cluster.GetId(),
+			dependents:        resources.NewGroupKindSet(gks...),
+			migration:         c.GetEnvironment().ControllerManager().GetClusterIdMigration(),
+			gkMigration:       c.GetEnvironment().ControllerManager().GetGroupKindMigration(),
+			usages:            NewSimpleUsageCache(),
+			orphans:           map[resources.ClusterObjectKey]*OrphanReport{},
+		}, nil
+	}
+}
@@ -0,0 +1,415 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package reconcilers
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+type recordingLogger struct {
+	logger.LogContext
+	infos []string
+}
+
+func (this *recordingLogger) Infof(msgfmt string, args ...interface{}) {
+	this.infos = append(this.infos, fmt.Sprintf(msgfmt, args...))
+}
+
+// gcCluster is a cluster providing owner objects by key. Objects
+// contained in cached are found in the cache, objects contained in
+// stored are found by a direct lookup, only.
+type gcCluster struct {
+	cluster.Interface
+	id     string
+	gks    resources.GroupKindSet
+	cached resources.ClusterObjectKeySet
+	stored resources.ClusterObjectKeySet
+	err    error
+	lookup int
+}
+
+func (this *gcCluster) GetId() string {
+	return this.id
+}
+
+func (this *gcCluster) Resources() resources.Resources {
+	return &gcResources{cluster: this}
+}
+
+func (this *gcCluster) GetCachedObject(spec interface{}) (resources.Object, error) {
+	key := spec.(resources.ClusterObjectKey)
+	if this.err != nil {
+		return nil, this.err
+	}
+	if this.cached.Contains(key) {
+		return &gcObject{}, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: key.Kind()}, key.Name())
+}
+
+func (this *gcCluster) GetObject(spec interface{}) (resources.Object, error) {
+	key := spec.(resources.ClusterObjectKey)
+	this.lookup++
+	if this.stored.Contains(key) {
+		return &gcObject{}, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: key.Kind()}, key.Name())
+}
+
+// resourcesBase is embedded under a name not conflicting
+// with the Resources method of the interface.
+type resourcesBase = resources.Resources
+
+type gcResources struct {
+	resourcesBase
+	cluster *gcCluster
+}
+
+func (this *gcResources) GetByGK(gk schema.GroupKind) (resources.Interface, error) {
+	if this.cluster.gks.Contains(gk) {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unknown resource %s", gk)
+}
+
+type gcController struct {
+	controller.Interface
+	clusters map[string]cluster.Interface
+}
+
+func (this *gcController) GetClusterById(id string) cluster.Interface {
+	return this.clusters[id]
+}
+
+type gcSource struct {
+	resources.Cluster
+	id string
+}
+
+func (this *gcSource) GetId() string {
+	return this.id
+}
+
+// gcObject is a dependent object with owner annotation.
+type gcObject struct {
+	resources.Object
+	key       resources.ClusterObjectKey
+	data      *corev1.Secret
+	deleteErr error
+	deleted   int
+}
+
+func (this *gcObject) ClusterKey() resources.ClusterObjectKey {
+	return this.key
+}
+
+func (this *gcObject) Data() resources.ObjectData {
+	return this.data
+}
+
+func (this *gcObject) GetCluster() resources.Cluster {
+	return &gcSource{id: this.key.Cluster()}
+}
+
+func (this *gcObject) IsDeleting() bool {
+	return this.data.DeletionTimestamp != nil
+}
+
+func (this *gcObject) GetOwnerReferences() []metav1.OwnerReference {
+	return this.data.OwnerReferences
+}
+
+func (this *gcObject) Delete() error {
+	this.deleted++
+	return this.deleteErr
+}
+
+type gcMigration map[string]string
+
+func (this gcMigration) RequireMigration(id string) string {
+	return this[id]
+}
+
+func (this gcMigration) String() string {
+	return fmt.Sprintf("%v", map[string]string(this))
+}
+
+type gcGKMigration map[schema.GroupKind]schema.GroupKind
+
+func (this gcGKMigration) RequireMigration(gk schema.GroupKind) *schema.GroupKind {
+	if m, ok := this[gk]; ok {
+		return &m
+	}
+	return nil
+}
+
+var _ = Describe("GarbageCollector", func() {
+	secrets := resources.NewGroupKind("", "Secret")
+	examples := resources.NewGroupKind("example.org", "Example")
+
+	It("registers the report command for the reconciler", func() {
+		def := controller.Configure("test").
+			With(GarbageCollectorForGKs("gc", "target", GarbageCollectorSpec{}, secrets)).
+			Definition()
+
+		Expect(def.Reconcilers()).To(HaveKey("gc"))
+		cmds := def.Commands()["gc"]
+		Expect(cmds).To(HaveLen(1))
+		Expect(cmds[0].Reconciler()).To(Equal("gc"))
+		Expect(cmds[0].Key().Match(GC_REPORT_COMMAND)).To(BeTrue())
+	})
+
+	It("registers the report command only once", func() {
+		def := controller.Configure("test").
+			With(GarbageCollectorForGKs("gc", "target", GarbageCollectorSpec{}, secrets)).
+			With(GarbageCollectorForGKs("gc", "target", GarbageCollectorSpec{}, examples)).
+			Definition()
+
+		Expect(def.Commands()["gc"]).To(HaveLen(1))
+	})
+
+	It("logs the orphans on the report command", func() {
+		since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		a := resources.NewClusterKey("target", secrets, "default", "a")
+		b := resources.NewClusterKey("target", secrets, "default", "b")
+		owner := resources.NewClusterKey("main", examples, "default", "owner")
+		gc := &GarbageCollector{
+			orphans: map[resources.ClusterObjectKey]*OrphanReport{
+				b: {Key: b, Owners: resources.NewClusterObjectKeySet(owner), Since: since, Deleted: true},
+				a: {Key: a, Owners: resources.NewClusterObjectKeySet(owner), Since: since},
+			},
+		}
+
+		log := &recordingLogger{LogContext: logger.New()}
+		status := gc.Command(log, GC_REPORT_COMMAND)
+
+		Expect(status.Completed).To(BeTrue())
+		Expect(status.Error).To(BeNil())
+		Expect(log.infos).To(Equal([]string{
+			"found 2 orphaned object(s)",
+			"  " + OrphanReport{Key: a, Owners: resources.NewClusterObjectKeySet(owner), Since: since}.String(),
+			"  " + OrphanReport{Key: b, Owners: resources.NewClusterObjectKeySet(owner), Since: since, Deleted: true}.String(),
+		}))
+		Expect(log.infos[2]).To(ContainSubstring("deleted since 2026-01-01T00:00:00Z"))
+	})
+
+	Context("reconcile", func() {
+		owner := resources.NewClusterKey("main", examples, "default", "owner")
+		oldOwner := resources.NewClusterKey("old", examples, "default", "owner")
+		legacyOwner := resources.NewClusterKey("main", resources.NewGroupKind("legacy.org", "Example"), "default", "owner")
+		dependent := resources.NewClusterKey("target", secrets, "default", "dependent")
+
+		type gcCase struct {
+			key       resources.ClusterObjectKey
+			owners    []resources.ClusterObjectKey
+			ownerRefs bool
+			deleting  bool
+			cached    bool
+			stored    bool
+			lookupErr error
+			deleteErr error
+			spec      GarbageCollectorSpec
+			migrated  bool
+
+			completed bool
+			err       string
+			interval  time.Duration
+			deleted   int
+			lookups   int
+			orphan    *bool
+		}
+		yes, no := true, false
+
+		var (
+			main *gcCluster
+			gc   *GarbageCollector
+		)
+
+		BeforeEach(func() {
+			main = &gcCluster{
+				id:     "main",
+				gks:    resources.NewGroupKindSet(examples),
+				cached: resources.NewClusterObjectKeySet(),
+				stored: resources.NewClusterObjectKeySet(),
+			}
+		})
+
+		DescribeTable("handles the dependents",
+			func(c gcCase) {
+				if c.cached {
+					main.cached.Add(owner)
+				}
+				if c.stored {
+					main.stored.Add(owner)
+				}
+				main.err = c.lookupErr
+				spec := c.spec
+				if spec.GracePeriod == 0 {
+					spec.GracePeriod = time.Hour
+				}
+				gc = &GarbageCollector{
+					ReconcilerSupport: ReconcilerSupport{controller: &gcController{clusters: map[string]cluster.Interface{"main": main}}},
+					spec:              spec,
+					clusterId:         "target",
+					dependents:        resources.NewGroupKindSet(secrets),
+					usages:            NewSimpleUsageCache(),
+					orphans:           map[resources.ClusterObjectKey]*OrphanReport{},
+				}
+				if c.migrated {
+					gc.migration = gcMigration{"old": "main"}
+					gc.gkMigration = gcGKMigration{legacyOwner.GroupKind(): examples}
+				}
+
+				key := c.key
+				if key == (resources.ClusterObjectKey{}) {
+					key = dependent
+				}
+				data := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace(), Name: key.Name()}}
+				if len(c.owners) > 0 {
+					annotation := ""
+					for i, o := range c.owners {
+						if i > 0 {
+							annotation += ","
+						}
+						annotation += o.String()
+					}
+					data.Annotations = map[string]string{"resources.gardener.cloud/owners": annotation}
+				}
+				if c.ownerRefs {
+					data.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "local"}}
+				}
+				if c.deleting {
+					now := metav1.Now()
+					data.DeletionTimestamp = &now
+				}
+				obj := &gcObject{key: key, data: data, deleteErr: c.deleteErr}
+
+				status := gc.Reconcile(logger.New(), obj)
+				Expect(status.Completed).To(Equal(c.completed))
+				if c.err != "" {
+					Expect(status.Error).To(MatchError(ContainSubstring(c.err)))
+				} else {
+					Expect(status.Error).To(BeNil())
+				}
+				Expect(status.Interval).To(BeNumerically("~", c.interval, time.Second))
+				Expect(obj.deleted).To(Equal(c.deleted))
+				Expect(main.lookup).To(Equal(c.lookups))
+
+				if c.orphan == nil {
+					Expect(gc.Report()).To(BeEmpty())
+				} else {
+					Expect(gc.Report()).To(HaveLen(1))
+					Expect(gc.Report()[0].Key).To(Equal(key))
+					Expect(gc.Report()[0].Deleted).To(Equal(*c.orphan))
+				}
+			},
+			Entry("ignores objects of other resources", gcCase{
+				key: resources.NewClusterKey("target", examples, "default", "other"), owners: []resources.ClusterObjectKey{owner},
+				completed: true, interval: -1,
+			}),
+			Entry("ignores objects being deleted", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, deleting: true,
+				completed: true, interval: -1,
+			}),
+			Entry("ignores objects without annotated owners", gcCase{
+				completed: true, interval: -1,
+			}),
+			Entry("keeps objects with a cached owner", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, cached: true,
+				completed: true, interval: time.Hour, spec: GarbageCollectorSpec{RecheckInterval: time.Hour},
+			}),
+			Entry("confirms a missing owner by a direct lookup", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, stored: true,
+				completed: true, interval: -1, lookups: 1,
+			}),
+			Entry("delays on owner lookup errors", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, lookupErr: fmt.Errorf("connection refused"),
+				completed: true, err: "cannot check owner", interval: -1,
+			}),
+			Entry("keeps objects with owners on unknown clusters", gcCase{
+				owners:    []resources.ClusterObjectKey{oldOwner},
+				completed: true, interval: -1,
+			}),
+			Entry("keeps objects with owners of unknown resources", gcCase{
+				owners:    []resources.ClusterObjectKey{legacyOwner},
+				completed: true, interval: -1,
+			}),
+			Entry("leaves objects with owner references to the kubernetes garbage collector", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, ownerRefs: true,
+				completed: true, interval: -1, lookups: 1,
+			}),
+			Entry("waits for the grace period", gcCase{
+				owners:    []resources.ClusterObjectKey{owner},
+				completed: true, interval: time.Hour, lookups: 1, orphan: &no,
+			}),
+			Entry("only reports orphans in dry run mode", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, spec: GarbageCollectorSpec{GracePeriod: time.Nanosecond, DryRun: true},
+				completed: true, interval: -1, lookups: 1, orphan: &no,
+			}),
+			Entry("deletes orphans after the grace period", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, spec: GarbageCollectorSpec{GracePeriod: time.Nanosecond},
+				completed: true, interval: -1, lookups: 1, deleted: 1, orphan: &yes,
+			}),
+			Entry("accepts orphans already deleted", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, spec: GarbageCollectorSpec{GracePeriod: time.Nanosecond},
+				deleteErr: apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "dependent"),
+				completed: true, interval: -1, lookups: 1, deleted: 1, orphan: &yes,
+			}),
+			Entry("delays on delete errors", gcCase{
+				owners: []resources.ClusterObjectKey{owner}, spec: GarbageCollectorSpec{GracePeriod: time.Nanosecond},
+				deleteErr: fmt.Errorf("forbidden"),
+				completed: true, err: "cannot delete orphaned object", interval: -1, lookups: 1, deleted: 1, orphan: &no,
+			}),
+			Entry("migrates the cluster ids of annotated owners", gcCase{
+				owners: []resources.ClusterObjectKey{oldOwner}, spec: GarbageCollectorSpec{GracePeriod: time.Nanosecond}, migrated: true,
+				completed: true, interval: -1, lookups: 1, deleted: 1, orphan: &yes,
+			}),
+			Entry("migrates the group kinds of annotated owners", gcCase{
+				owners: []resources.ClusterObjectKey{legacyOwner}, spec: GarbageCollectorSpec{GracePeriod: time.Nanosecond}, migrated: true,
+				completed: true, interval: -1, lookups: 1, deleted: 1, orphan: &yes,
+			}),
+		)
+
+		It("keeps the orphan time across reconcilations", func() {
+			gc = &GarbageCollector{
+				ReconcilerSupport: ReconcilerSupport{controller: &gcController{clusters: map[string]cluster.Interface{"main": main}}},
+				spec:              GarbageCollectorSpec{GracePeriod: time.Hour},
+				clusterId:         "target",
+				dependents:        resources.NewGroupKindSet(secrets),
+				usages:            NewSimpleUsageCache(),
+				orphans:           map[resources.ClusterObjectKey]*OrphanReport{},
+			}
+			data := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dependent",
+				Annotations: map[string]string{"resources.gardener.cloud/owners": owner.String()}}}
+			obj := &gcObject{key: dependent, data: data}
+
+			Expect(gc.Reconcile(logger.New(), obj).Interval).To(BeNumerically("<=", time.Hour))
+			since := gc.Report()[0].Since
+			Expect(gc.Reconcile(logger.New(), obj).Interval).To(BeNumerically("<", time.Hour))
+			Expect(gc.Report()[0].Since).To(Equal(since))
+
+			// owner reappeared
+			main.cached.Add(owner)
+			gc.Reconcile(logger.New(), obj)
+			Expect(gc.Report()).To(BeEmpty())
+		})
+	})
+})
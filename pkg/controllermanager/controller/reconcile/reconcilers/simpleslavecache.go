@@ -9,6 +9,7 @@ package reconcilers
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -33,9 +34,10 @@ func GetSharedSimpleSlaveCache(controller controller.Interface) *SimpleSlaveCach
 }
 
 type SimpleSlaveCache struct {
-	migration   resources.ClusterIdMigration
-	gkMigration resources.GroupKindMigration
-	usages      *SimpleUsageCache
+	migration    resources.ClusterIdMigration
+	gkMigration  resources.GroupKindMigration
+	usages       *SimpleUsageCache
+	expectations *resources.Expectations
 }
 
 func NewSimpleSlaveCache(clustermig resources.ClusterIdMigration, gkmig resources.GroupKindMigration) *SimpleSlaveCache {
@@ -46,6 +48,22 @@ func NewSimpleSlaveCache(clustermig resources.ClusterIdMigration, gkmig resource
 	}
 }
 
+// EnableExpectations enables the tracking of slave creations and deletions
+// done via the cache until they are observed by the slave reconciler.
+func (this *SimpleSlaveCache) EnableExpectations(timeout time.Duration) *SimpleSlaveCache {
+	if this.expectations == nil {
+		this.expectations = resources.NewExpectations(timeout)
+	}
+	return this
+}
+
+// ExpectationsSatisfied checks whether all slave creations and deletions
+// done for an owner are observed. If not, the remaining time until the
+// expectations expire is returned.
+func (this *SimpleSlaveCache) ExpectationsSatisfied(owner resources.ClusterObjectKey) (bool, time.Duration) {
+	return this.expectations.Satisfied(owner)
+}
+
 func (this *SimpleSlaveCache) GetOwnersFor(name resources.ClusterObjectKey, filter resources.KeyFilter) resources.ClusterObjectKeySet {
 	return this.usages.GetFilteredUsesFor(name, filter)
 }
@@ -56,9 +74,36 @@ func (this *SimpleSlaveCache) GetSlavesFor(name resources.ClusterObjectKey, filt
 
 func (this *SimpleSlaveCache) CreateSlaveFor(obj resources.Object, slave resources.Object) error {
 	slave.AddOwner(obj)
+	named := slave.GetName() != ""
+	template := slave.ClusterKey()
+	if named {
+		this.expectations.ExpectCreations(obj.ClusterKey(), template)
+	} else {
+		known := this.GetSlavesFor(obj.ClusterKey(), nil)
+		this.expectations.ExpectGeneratedCreations(obj.ClusterKey(), template, 1, known.AsArray()...)
+	}
 	err := slave.Create()
-	if err == nil {
+	switch {
+	case err == nil:
+		if !named {
+			this.expectations.GeneratedCreationSucceeded(obj.ClusterKey(), slave.ClusterKey())
+		}
 		this.usages.UpdateUsesFor(slave.ClusterKey(), slave.GetOwners())
+	case named:
+		this.expectations.CancelExpectation(obj.ClusterKey(), template)
+	default:
+		this.expectations.LowerGeneratedCreations(obj.ClusterKey(), template, 1)
+	}
+	return err
+}
+
+// DeleteSlaveFor deletes a slave of the given owner. If expectations are enabled
+// the deletion is expected until it is observed by the slave reconciler.
+func (this *SimpleSlaveCache) DeleteSlaveFor(obj resources.Object, slave resources.Object) error {
+	this.expectations.ExpectDeletions(obj.ClusterKey(), slave.ClusterKey())
+	err := slave.Delete()
+	if err != nil {
+		this.expectations.CancelExpectation(obj.ClusterKey(), slave.ClusterKey())
 	}
 	return err
 }
@@ -91,6 +136,7 @@ func (this *SimpleSlaveCache) SetupFor(log logger.LogContext, resc resources.Int
 }
 
 func (this *SimpleSlaveCache) DeleteSlave(log logger.LogContext, msg string, controller controller.Interface, slave resources.ClusterObjectKey, actions ...KeyAction) error {
+	this.expectations.DeletionObserved(slave)
 	return this.usages.CleanupUser(log, msg, controller, slave, actions...)
 }
 
@@ -163,6 +209,7 @@ func (this *slaveReconciler) Setup() error {
 }
 
 func (this *slaveReconciler) Reconcile(logger logger.LogContext, obj resources.Object) reconcile.Status {
+	this.cache.expectations.CreationObserved(obj.ClusterKey(), obj.GetOwners().AsArray()...)
 	if err := this.cache.ExecuteActionForOwnersOf(logger, "changed -> trigger", this.controller, obj.ClusterKey(), nil, GlobalEnqueueAction); err != nil {
 		return reconcile.Failed(logger, err)
 	}
@@ -8,6 +8,7 @@ package reconcilers
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -119,6 +120,10 @@ type SlaveAccessSpec struct {
 
 	ClusterIdMigration resources.ClusterIdMigration
 	GroupKindMigration resources.GroupKindMigration
+
+	// ExpectationTimeout enables the tracking of slave creations and
+	// deletions (see AwaitExpectations) if greater than zero.
+	ExpectationTimeout time.Duration
 }
 
 func NewSlaveAccessSpec(c controller.Interface, name string, slave_func Resources, master_func Resources) SlaveAccessSpec {
@@ -172,6 +177,9 @@ func (this *SlaveAccess) Key() interface{} {
 
 func (this *SlaveAccess) Setup() {
 	this.slaves = this.GetOrCreateSharedValue(this.Key(), this.setupSlaveCache).(*resources.SlaveCache)
+	if this.spec.ExpectationTimeout > 0 {
+		this.slaves.EnableExpectations(this.spec.ExpectationTimeout)
+	}
 }
 
 func (this *SlaveAccess) AddSlaveFilter(filter ...resources.ObjectFilter) {
@@ -209,6 +217,26 @@ func (this *SlaveAccess) CreateOrModifySlave(obj resources.Object, slave resourc
 	return this.slaves.CreateOrModifySlave(obj, slave, mod)
 }
 
+// DeleteSlave deletes a slave of the given owner. If expectations are enabled
+// the owner is kept back by AwaitExpectations until the deletion is observed.
+func (this *SlaveAccess) DeleteSlave(obj resources.Object, slave resources.Object) error {
+	return this.slaves.DeleteSlaveObject(obj, slave)
+}
+
+// AwaitExpectations checks whether all slave creations and deletions done for
+// the given owner are already observed by the slave cache. If not, false is
+// returned together with a status rescheduling the owner when the expectations
+// expire. The owner is requeued earlier, when the pending slave events are
+// observed by the slave reconciler.
+func (this *SlaveAccess) AwaitExpectations(logger logger.LogContext, key resources.ClusterObjectKey) (bool, reconcile.Status) {
+	ok, remaining := this.slaves.ExpectationsSatisfied(key)
+	if ok {
+		return true, reconcile.Succeeded(logger)
+	}
+	logger.Infof("awaiting pending %s changes for %s", this.name, key.ObjectName())
+	return false, reconcile.RescheduleAfter(logger, remaining)
+}
+
 func (this *SlaveAccess) UpdateSlave(slave resources.Object) error {
 	return this.slaves.UpdateSlave(slave)
 }
@@ -245,8 +273,10 @@ func (this *SlaveAccess) AssertSingleSlave(logger logger.LogContext, key resourc
 				} else {
 					found, o = o, found
 				}
+				this.slaves.Expectations().ExpectDeletions(key, o.ClusterKey())
 				err := o.Delete()
 				if err != nil {
+					this.slaves.Expectations().CancelExpectation(key, o.ClusterKey())
 					logger.Warnf("cleanup of obsolete %s %s for %s failed %s", this.name, o.ObjectName(), key.ObjectName(), err)
 				} else {
 					logger.Infof("cleanup of obsolete %s %s for %s", this.name, o.ObjectName(), key.ObjectName())
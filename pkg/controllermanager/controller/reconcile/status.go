@@ -8,6 +8,30 @@ package reconcile
 
 import "time"
 
+// Outcome describes the kind of result of a reconcilation.
+type Outcome string
+
+const (
+	OutcomeSucceeded Outcome = "Succeeded"
+	OutcomeDelayed   Outcome = "Delayed"
+	OutcomeFailed    Outcome = "Failed"
+	OutcomeRepeat    Outcome = "Repeat"
+)
+
+// Outcome returns the kind of result described by the status.
+func (this Status) Outcome() Outcome {
+	switch {
+	case this.IsSucceeded():
+		return OutcomeSucceeded
+	case this.IsDelayed():
+		return OutcomeDelayed
+	case this.IsFailed():
+		return OutcomeFailed
+	default:
+		return OutcomeRepeat
+	}
+}
+
 func (this Status) IsSucceeded() bool {
 	return this.Completed && this.Error == nil
 }
@@ -0,0 +1,114 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package reconcile
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// TypedReconcileFunction is a reconcile function for a dedicated object type.
+// Besides the typed object data the generic object is passed to provide
+// access to the generic object functionality (update, cluster, events, ...).
+type TypedReconcileFunction[T runtime.Object] func(logger.LogContext, T, resources.Object) Status
+
+// DeletedFunction is a function called for finally deleted objects.
+type DeletedFunction func(logger.LogContext, resources.ClusterObjectKey) Status
+
+// TypedInterface is implemented by reconcilers restricted
+// to handle objects of a dedicated object type.
+type TypedInterface interface {
+	Interface
+	ObjectType() reflect.Type
+}
+
+// TypedReconciler is a reconciler adapter mapping the generic
+// reconciler interface to typed reconcile functions.
+type TypedReconciler[T runtime.Object] struct {
+	DefaultReconciler
+	reconcile TypedReconcileFunction[T]
+	delete    TypedReconcileFunction[T]
+	deleted   DeletedFunction
+}
+
+var _ TypedInterface = &TypedReconciler[runtime.Object]{}
+
+// Typed creates a reconciler for objects of type T using the given
+// reconcile function. Delete and Deleted default to Succeeded and can
+// be set with WithDelete and WithDeleted.
+func Typed[T runtime.Object](f TypedReconcileFunction[T]) *TypedReconciler[T] {
+	return &TypedReconciler[T]{reconcile: f}
+}
+
+func (this *TypedReconciler[T]) WithDelete(f TypedReconcileFunction[T]) *TypedReconciler[T] {
+	this.delete = f
+	return this
+}
+
+func (this *TypedReconciler[T]) WithDeleted(f DeletedFunction) *TypedReconciler[T] {
+	this.deleted = f
+	return this
+}
+
+func (this *TypedReconciler[T]) ObjectType() reflect.Type {
+	return TypeOf[T]()
+}
+
+func (this *TypedReconciler[T]) Reconcile(logger logger.LogContext, obj resources.Object) Status {
+	if this.reconcile == nil {
+		return Succeeded(logger)
+	}
+	data, err := ObjectData[T](obj)
+	if err != nil {
+		return Failed(logger, err)
+	}
+	return this.reconcile(logger, data, obj)
+}
+
+func (this *TypedReconciler[T]) Delete(logger logger.LogContext, obj resources.Object) Status {
+	if this.delete == nil {
+		return Succeeded(logger)
+	}
+	data, err := ObjectData[T](obj)
+	if err != nil {
+		return Failed(logger, err)
+	}
+	return this.delete(logger, data, obj)
+}
+
+func (this *TypedReconciler[T]) Deleted(logger logger.LogContext, key resources.ClusterObjectKey) Status {
+	if this.deleted == nil {
+		return Succeeded(logger)
+	}
+	return this.deleted(logger, key)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// TypeOf returns the reflect type for the object type T.
+func TypeOf[T runtime.Object]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// ObjectData returns the data of a generic object as object type T.
+// If the object data is of another type an error is returned.
+func ObjectData[T runtime.Object](obj resources.Object) (T, error) {
+	var zero T
+	if obj == nil {
+		return zero, fmt.Errorf("no object given")
+	}
+	data, ok := obj.Data().(T)
+	if !ok {
+		return zero, fmt.Errorf("unexpected object type %T for %s (expected %s)", obj.Data(), obj.ClusterKey(), TypeOf[T]())
+	}
+	return data, nil
+}
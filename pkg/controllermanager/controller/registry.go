@@ -105,6 +105,9 @@ func (this *_Registry) RegisterController(reg Registerable, group ...string) err
 	if def.MainWatchResource() == nil {
 		return fmt.Errorf("no main resource for controller %q", def.Name())
 	}
+	if err := validateReconcilerTypes(def); err != nil {
+		return err
+	}
 	if d, ok := this.definitions[def.Name()]; ok && d != def {
 		return fmt.Errorf("multiple registration of controller %q", def.Name())
 	}
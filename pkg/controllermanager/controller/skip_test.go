@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/controller/config"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+	"github.com/gardener/controller-manager-library/pkg/sync"
+)
+
+type testObjectInfo struct {
+	resources.ObjectInfo
+}
+
+func (this *testObjectInfo) Description() string {
+	return "default/test"
+}
+
+// testEnvironment provides the context of test controllers.
+type testEnvironment struct {
+	Environment
+	ctx context.Context
+}
+
+func (this *testEnvironment) GetContext() context.Context {
+	return this.ctx
+}
+
+var _ = Describe("Skipped controllers", func() {
+	var (
+		ext     *Extension
+		cntr    *controller
+		syncErr *resources.CacheSyncError
+	)
+
+	newController := func(name string) *controller {
+		c := &controller{env: &testEnvironment{ctx: context.Background()}}
+		c.ElementBase = extension.NewElementBase(context.Background(), ctx_controller, c, name, CONTROLLER_SET_PREFIX, nil)
+		c.ready.start()
+		return c
+	}
+
+	BeforeEach(func() {
+		cntr = newController("test")
+		ext = &Extension{
+			config:      &areacfg.Config{},
+			controllers: controllers{cntr},
+			prepared:    map[string]*sync.SyncPoint{"test": {}},
+			started:     map[string]*sync.SyncPoint{"test": {}},
+		}
+		syncErr = &resources.CacheSyncError{
+			GroupVersionKind: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			Cluster:          "default",
+			Timeout:          time.Minute,
+			Err:              errors.New("deployments.apps is forbidden"),
+		}
+	})
+
+	It("returns other preparation errors unchanged", func() {
+		err := errors.New("no cluster")
+		ext.config.SkipUnsynced = true
+		gomega.Expect(ext.prepareFailed(cntr, err)).To(gomega.BeIdenticalTo(err))
+		gomega.Expect(ext.started["test"].IsReached()).To(gomega.BeFalse())
+	})
+
+	It("fails with the cache sync error by default", func() {
+		err := ext.prepareFailed(cntr, syncErr)
+		gomega.Expect(err).To(gomega.MatchError("cannot start controller test: cache for apps/v1, Kind=Deployment of cluster default not synced within 1m0s: deployments.apps is forbidden"))
+
+		var target *resources.CacheSyncError
+		gomega.Expect(errors.As(err, &target)).To(gomega.BeTrue())
+		gomega.Expect(target.GroupVersionKind.Kind).To(gomega.Equal("Deployment"))
+		gomega.Expect(ext.started["test"].IsReached()).To(gomega.BeFalse())
+
+		status := cntr.readinessCheck(nil).Check()
+		gomega.Expect(status.Healthy).To(gomega.BeFalse())
+		gomega.Expect(status.Reason).To(gomega.Equal("controller not started"))
+	})
+
+	It("skips the controller with --controller-skip-unsynced", func() {
+		ext.config.SkipUnsynced = true
+		gomega.Expect(ext.prepareFailed(cntr, syncErr)).To(gomega.Succeed())
+		gomega.Expect(ext.started["test"].IsReached()).To(gomega.BeTrue())
+		gomega.Expect(cntr.IsReady()).To(gomega.BeFalse())
+
+		status := cntr.readinessCheck(nil).Check()
+		gomega.Expect(status.Healthy).To(gomega.BeTrue())
+		gomega.Expect(status.Reason).To(gomega.Equal("skipped (0 events dropped): " + syncErr.Error()))
+	})
+
+	It("counts the dropped events of a skipped controller", func() {
+		obj := &testObjectInfo{}
+		gomega.Expect(cntr.dropEvent(obj)).To(gomega.BeFalse())
+
+		ext.config.SkipUnsynced = true
+		gomega.Expect(ext.prepareFailed(cntr, syncErr)).To(gomega.Succeed())
+
+		handler := &ClusterHandler{controller: cntr, resources: map[ResourceKey]*clusterResourceInfo{}}
+		gomega.Expect(handler.EnqueueObject(obj)).To(gomega.Succeed())
+		gomega.Expect(handler.EnqueueObjectRateLimited(obj)).To(gomega.Succeed())
+
+		dropped, err := cntr.skipState()
+		gomega.Expect(dropped).To(gomega.Equal(2))
+		gomega.Expect(err).To(gomega.BeIdenticalTo(syncErr))
+		gomega.Expect(cntr.readinessCheck(nil).Check().Reason).To(gomega.HavePrefix("skipped (2 events dropped): "))
+	})
+
+	It("skips controllers ordered after a skipped controller", func() {
+		dep := newController("dependent")
+		ext.controllers = append(ext.controllers, dep)
+		ext.started["dependent"] = &sync.SyncPoint{}
+		ext.after = map[string][]string{"dependent": {"test"}}
+		ext.config.SkipUnsynced = true
+
+		done := make(chan error, 1)
+		go func() {
+			done <- ext.startController(dep)
+		}()
+		gomega.Consistently(done, 100*time.Millisecond).ShouldNot(gomega.Receive())
+
+		gomega.Expect(ext.prepareFailed(cntr, syncErr)).To(gomega.Succeed())
+		gomega.Eventually(done).Should(gomega.Receive(gomega.BeNil()))
+		gomega.Expect(ext.started["dependent"].IsReached()).To(gomega.BeTrue())
+		gomega.Expect(dep.IsReady()).To(gomega.BeFalse())
+
+		status := dep.readinessCheck(nil).Check()
+		gomega.Expect(status.Healthy).To(gomega.BeTrue())
+		gomega.Expect(status.Reason).To(gomega.Equal(`skipped (0 events dropped): controller "test" ordered before has been skipped`))
+	})
+
+	It("aborts waiting for predecessors with the context", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		dep := newController("dependent")
+		dep.env = &testEnvironment{ctx: ctx}
+		ext.controllers = append(ext.controllers, dep)
+		ext.after = map[string][]string{"dependent": {"test"}}
+		cancel()
+		gomega.Expect(ext.startController(dep)).To(gomega.MatchError("start aborted"))
+	})
+})
@@ -165,7 +165,7 @@ func (this *Extension) getPlainStartupGroup(cluster cluster.Interface) StartupGr
 func (this *Extension) getLeaseStartupGroup(cluster cluster.Interface) StartupGroup {
 	g := this.lease_groups[cluster.GetName()]
 	if g == nil {
-		g = &leasestartupgroup{startupgroup{this, cluster, nil}}
+		g = &leasestartupgroup{startupgroup: startupgroup{this, cluster, nil}}
 		this.lease_groups[cluster.GetName()] = g
 	}
 	return g
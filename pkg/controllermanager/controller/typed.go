@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/reconcile"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/watches"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// GroupKindFor determines the group kind registered for the object type T
+// in the given scheme. If no scheme is given the default scheme is used.
+func GroupKindFor[T runtime.Object](scheme *runtime.Scheme) (schema.GroupKind, error) {
+	return watches.GroupKindForType(scheme, reconcile.TypeOf[T]())
+}
+
+// TypedMainResource configures the main resource of a controller by the
+// object type T. The group kind is determined by the scheme used for the
+// cluster, when the controller is created.
+func TypedMainResource[T runtime.Object](c Configuration, sel ...WatchSelectionFunction) Configuration {
+	return c.FlavoredMainResource(legacy(watches.TypedResourceFlavors(reconcile.TypeOf[T]()), sel...))
+}
+
+// TypedWatch adds a watch for the object type T for the actual reconciler.
+func TypedWatch[T runtime.Object](c Configuration, sel ...WatchSelectionFunction) Configuration {
+	return c.FlavoredReconcilerWatches(c.reconciler, legacy(watches.TypedResourceFlavors(reconcile.TypeOf[T]()), sel...))
+}
+
+// TypedReconciler configures a reconciler restricted to objects of type T.
+// All resources reconciled by this reconciler must match the object type T,
+// which is validated when registering and creating the controller.
+func TypedReconciler[T runtime.Object](c Configuration, t ReconcilerType, name ...string) Configuration {
+	c = c.Reconciler(t, name...)
+	types := map[string]reflect.Type{}
+	for n, t := range c.settings.types {
+		types[n] = t
+	}
+	if len(name) == 0 {
+		types[DEFAULT_RECONCILER] = reconcile.TypeOf[T]()
+	}
+	for _, n := range name {
+		types[n] = reconcile.TypeOf[T]()
+	}
+	c.settings.types = types
+	return c
+}
+
+// TypedReconcilerFunction configures a reconciler for the reconcile
+// function of a typed reconciler restricted to objects of type T.
+func TypedReconcilerFunction[T runtime.Object](c Configuration, f reconcile.TypedReconcileFunction[T], name ...string) Configuration {
+	return TypedReconciler[T](c, func(Interface) (reconcile.Interface, error) {
+		return reconcile.Typed[T](f), nil
+	}, name...)
+}
+
+// GetTypedObject reads the object for the given key and provides
+// its data as object type T.
+func GetTypedObject[T runtime.Object](c Interface, key resources.ClusterObjectKey) (T, resources.Object, error) {
+	obj, err := c.GetObject(key)
+	return typedObject[T](obj, err)
+}
+
+// GetTypedCachedObject reads the cached object for the given key and
+// provides its data as object type T.
+func GetTypedCachedObject[T runtime.Object](c Interface, key resources.ClusterObjectKey) (T, resources.Object, error) {
+	obj, err := c.GetCachedObject(key)
+	return typedObject[T](obj, err)
+}
+
+func typedObject[T runtime.Object](obj resources.Object, err error) (T, resources.Object, error) {
+	var zero T
+	if err != nil {
+		return zero, nil, err
+	}
+	data, err := reconcile.ObjectData[T](obj)
+	if err != nil {
+		return zero, nil, err
+	}
+	return data, obj, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// validateReconcilerTypes checks that the main resource and all watches
+// handled by typed reconcilers match the object type of the reconciler.
+// Resources given by group kind can only be checked if the object type is
+// already known by the scheme. Otherwise the check is done again when the
+// controller is created.
+func validateReconcilerTypes(def Definition) error {
+	for n, t := range def.ReconcilerTypes() {
+		if n == DEFAULT_RECONCILER {
+			if err := checkWatchResourceType(def.Scheme(), def.MainWatchResource(), t); err != nil {
+				return fmt.Errorf("controller %q: main resource for reconciler %q: %s", def.Name(), n, err)
+			}
+		}
+		for cname, watches := range def.Watches() {
+			for _, w := range watches {
+				if w.Reconciler() != n {
+					continue
+				}
+				if err := checkWatchResourceType(def.Scheme(), w, t); err != nil {
+					return fmt.Errorf("controller %q: watch on cluster %q for reconciler %q: %s", def.Name(), cname, n, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkWatchResourceType(scheme *runtime.Scheme, w WatchResource, t reflect.Type) error {
+	if k, ok := w.(interface{ ObjectTypes() []reflect.Type }); ok {
+		for _, ot := range k.ObjectTypes() {
+			if ot != t {
+				return fmt.Errorf("resource type %s does not match reconciler type %s", ot, t)
+			}
+		}
+	}
+	if k, ok := w.(interface{ ResourceKeys() []ResourceKey }); ok {
+		keys := k.ResourceKeys()
+		if len(keys) == 0 {
+			return nil
+		}
+		gk, err := watches.GroupKindForType(scheme, t)
+		if err != nil {
+			// type not yet registered, check later on controller creation
+			return nil
+		}
+		for _, key := range keys {
+			if key.GroupKind() != gk {
+				return fmt.Errorf("resource %s does not match reconciler type %s (%s)", key.GroupKind(), t, gk)
+			}
+		}
+	}
+	return nil
+}
+
+// checkReconcilerType checks a resource handled by a typed reconciler
+// against the object type of the reconciler using the scheme of the
+// cluster the resource is watched on.
+func (this *controller) checkReconcilerType(cluster cluster.Interface, reconciler string, gk schema.GroupKind) error {
+	t, ok := this.definition.ReconcilerTypes()[reconciler]
+	if !ok {
+		return nil
+	}
+	expected, err := watches.GroupKindForType(cluster.ResourceContext().Scheme(), t)
+	if err != nil {
+		return fmt.Errorf("reconciler %q: %s", reconciler, err)
+	}
+	if expected != gk {
+		return fmt.Errorf("resource %s on cluster %s does not match type %s of reconciler %q", gk, cluster.GetName(), t, reconciler)
+	}
+	return nil
+}
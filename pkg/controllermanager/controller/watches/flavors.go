@@ -9,6 +9,7 @@ package watches
 
 import (
 	"fmt"
+	"reflect"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -53,6 +54,12 @@ func (this *conditional) WatchResourceDef(wctx WatchContext, def WatchResourceDe
 func (this *conditional) RequestMinimalFor(gk schema.GroupKind) {
 	this.flavors.RequestMinimalFor(gk)
 }
+func (this *conditional) ResourceKeys() []ResourceKey {
+	return this.flavors.ResourceKeys()
+}
+func (this *conditional) ObjectTypes() []reflect.Type {
+	return this.flavors.ObjectTypes()
+}
 func (this *conditional) String() string {
 	s := ""
 	if this.cond != nil {
@@ -288,6 +295,9 @@ func (this *resourceFlavor) RequestMinimalFor(gk schema.GroupKind) {
 		this.minimal = true
 	}
 }
+func (this *resourceFlavor) ResourceKeys() []ResourceKey {
+	return []ResourceKey{this.key}
+}
 
 func (this *resourceFlavor) String() string {
 	if len(this.constraints) == 0 {
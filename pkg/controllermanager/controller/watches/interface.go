@@ -34,6 +34,9 @@ type WatchResourceDef struct {
 	Namespace string
 	Tweaker   []resources.TweakListOptionsFunc
 	Minimal   bool
+	// Err is set by a flavor matching the watch context,
+	// which cannot determine its resource.
+	Err error
 }
 
 ////////////////////////////////////////////////////////////////////////////////
@@ -53,7 +56,7 @@ func (this FlavoredResource) RequestMinimalFor(gk schema.GroupKind) {
 func (this FlavoredResource) WatchResourceDef(wctx WatchContext, def WatchResourceDef) WatchResourceDef {
 	for _, r := range this {
 		def = r.WatchResourceDef(wctx, def)
-		if def.Key != nil {
+		if def.Key != nil || def.Err != nil {
 			break
 		}
 	}
@@ -70,6 +73,18 @@ func (this FlavoredResource) String() string {
 	return s + "]"
 }
 
+// ResourceKeys returns all resource keys potentially selected by the flavors,
+// regardless of their constraints.
+func (this FlavoredResource) ResourceKeys() []ResourceKey {
+	var keys []ResourceKey
+	for _, f := range this {
+		if k, ok := f.(KeyedResourceFlavor); ok {
+			keys = append(keys, k.ResourceKeys()...)
+		}
+	}
+	return keys
+}
+
 func NewFlavoredResource(flavors ...ResourceFlavor) FlavoredResource {
 	return flavors
 }
@@ -82,3 +97,9 @@ type ResourceFlavor interface {
 	RequestMinimalFor(gk schema.GroupKind)
 	String() string
 }
+
+// KeyedResourceFlavor is an optional interface for resource flavors
+// able to statically report the resource keys they may select.
+type KeyedResourceFlavor interface {
+	ResourceKeys() []ResourceKey
+}
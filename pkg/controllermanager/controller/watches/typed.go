@@ -0,0 +1,133 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package watches
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// TypedResourceFlavor describes a resource by its object type. The group kind
+// is determined by the scheme of the cluster of the watch context, so the
+// object type need not be registered when the flavor is created.
+// If the type is unknown to the scheme, the watch resource definition
+// reports an error.
+func TypedResourceFlavor(t reflect.Type, constraints ...WatchConstraint) ResourceFlavor {
+	return &typedResourceFlavor{
+		otype:       t,
+		constraints: constraints,
+	}
+}
+
+type typedResourceFlavor struct {
+	constraints []WatchConstraint
+	otype       reflect.Type
+	minimal     []schema.GroupKind
+}
+
+func (this *typedResourceFlavor) WatchResourceDef(wctx WatchContext, def WatchResourceDef) WatchResourceDef {
+	if len(this.constraints) > 0 {
+		found := false
+		for _, c := range this.constraints {
+			if c.Check(wctx) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return def
+		}
+	}
+	gk, err := GroupKindForType(wctx.Cluster().ResourceContext().Scheme(), this.otype)
+	if err != nil {
+		def.Err = fmt.Errorf("resource for %s in controller %s: %s", this.otype, wctx.Name(), err)
+		return def
+	}
+	def.Key = extension.NewResourceKey(gk.Group, gk.Kind)
+	for _, m := range this.minimal {
+		if m == gk {
+			def.Minimal = true
+		}
+	}
+	return def
+}
+
+func (this *typedResourceFlavor) RequestMinimalFor(gk schema.GroupKind) {
+	this.minimal = append(this.minimal, gk)
+}
+
+func (this *typedResourceFlavor) ObjectTypes() []reflect.Type {
+	return []reflect.Type{this.otype}
+}
+
+func (this *typedResourceFlavor) String() string {
+	if len(this.constraints) == 0 {
+		return this.otype.String()
+	}
+	s := fmt.Sprintf("%s[", this.otype)
+	sep := ""
+	for _, c := range this.constraints {
+		s = fmt.Sprintf("%s%s%s", s, sep, c)
+		sep = ", "
+	}
+	return s + "]"
+}
+
+// TypedResourceFlavors describes a resource by its object type.
+func TypedResourceFlavors(t reflect.Type, constraints ...WatchConstraint) FlavoredResource {
+	return FlavoredResource{TypedResourceFlavor(t, constraints...)}
+}
+
+// ObjectTypedResourceFlavor is an optional interface for resource flavors
+// describing resources by their object type.
+type ObjectTypedResourceFlavor interface {
+	ObjectTypes() []reflect.Type
+}
+
+// ObjectTypes returns all object types potentially selected by the flavors.
+func (this FlavoredResource) ObjectTypes() []reflect.Type {
+	var types []reflect.Type
+	for _, f := range this {
+		if k, ok := f.(ObjectTypedResourceFlavor); ok {
+			types = append(types, k.ObjectTypes()...)
+		}
+	}
+	return types
+}
+
+// GroupKindForType determines the group kind registered for an object type
+// in the given scheme. If no scheme is given the default scheme is used.
+func GroupKindForType(scheme *runtime.Scheme, t reflect.Type) (schema.GroupKind, error) {
+	if scheme == nil {
+		scheme = resources.DefaultScheme()
+	}
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return schema.GroupKind{}, fmt.Errorf("object type %s must be a pointer to a struct", t)
+	}
+	obj, ok := reflect.New(t.Elem()).Interface().(runtime.Object)
+	if !ok {
+		return schema.GroupKind{}, fmt.Errorf("object type %s is no runtime object", t)
+	}
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil {
+		return schema.GroupKind{}, err
+	}
+	gk := gvks[0].GroupKind()
+	for _, gvk := range gvks[1:] {
+		if gvk.GroupKind() != gk {
+			return schema.GroupKind{}, fmt.Errorf("object type %s is registered for multiple group kinds (%s, %s)", t, gk, gvk.GroupKind())
+		}
+	}
+	return gk, nil
+}
@@ -0,0 +1,108 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package watches_test
+
+import (
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/controller/watches"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type resourceContext struct {
+	resources.ResourceContext
+	scheme *runtime.Scheme
+}
+
+func (this *resourceContext) Scheme() *runtime.Scheme {
+	return this.scheme
+}
+
+type testCluster struct {
+	cluster.Interface
+	rctx resources.ResourceContext
+}
+
+func (this *testCluster) ResourceContext() resources.ResourceContext {
+	return this.rctx
+}
+
+type watchContext struct {
+	watches.WatchContext
+	cluster cluster.Interface
+}
+
+func (this *watchContext) Name() string {
+	return "test"
+}
+
+func (this *watchContext) Cluster() cluster.Interface {
+	return this.cluster
+}
+
+var _ = Describe("Typed resource flavor", func() {
+	var wctx watches.WatchContext
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		wctx = &watchContext{cluster: &testCluster{rctx: &resourceContext{scheme: scheme}}}
+	})
+
+	It("determines the group kind by the cluster scheme", func() {
+		def := watches.TypedResourceFlavor(reflect.TypeOf(&corev1.Secret{})).WatchResourceDef(wctx, watches.WatchResourceDef{})
+		Expect(def.Err).To(BeNil())
+		Expect(def.Key.GroupKind()).To(Equal(schema.GroupKind{Kind: "Secret"}))
+		Expect(def.Minimal).To(BeFalse())
+	})
+
+	It("requests minimal watches", func() {
+		flavor := watches.TypedResourceFlavor(reflect.TypeOf(&corev1.Secret{}))
+		flavor.RequestMinimalFor(schema.GroupKind{Kind: "Secret"})
+		def := flavor.WatchResourceDef(wctx, watches.WatchResourceDef{})
+		Expect(def.Minimal).To(BeTrue())
+	})
+
+	It("reports an error for types unknown to the scheme", func() {
+		def := watches.TypedResourceFlavor(reflect.TypeOf(&appsv1.Deployment{})).WatchResourceDef(wctx, watches.WatchResourceDef{})
+		Expect(def.Key).To(BeNil())
+		Expect(def.Err).To(HaveOccurred())
+		Expect(def.Err.Error()).To(ContainSubstring("*v1.Deployment in controller test"))
+	})
+
+	It("is omitted if no constraint matches", func() {
+		never := watches.NewFunctionWatchConstraint(func(watches.WatchContext) bool { return false }, "never")
+		def := watches.TypedResourceFlavor(reflect.TypeOf(&appsv1.Deployment{}), never).WatchResourceDef(wctx, watches.WatchResourceDef{})
+		Expect(def.Key).To(BeNil())
+		Expect(def.Err).To(BeNil())
+	})
+
+	It("stops flavored resources on errors", func() {
+		flavors := watches.NewFlavoredResource(
+			watches.TypedResourceFlavor(reflect.TypeOf(&appsv1.Deployment{})),
+			watches.NewResourceFlavor("", "Secret"),
+		)
+		def := flavors.WatchResourceDef(wctx, watches.WatchResourceDef{})
+		Expect(def.Key).To(BeNil())
+		Expect(def.Err).To(HaveOccurred())
+	})
+
+	It("rejects non pointer types", func() {
+		_, err := watches.GroupKindForType(nil, reflect.TypeOf(corev1.Secret{}))
+		Expect(err).To(MatchError(ContainSubstring("must be a pointer to a struct")))
+	})
+})
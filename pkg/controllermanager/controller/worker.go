@@ -33,27 +33,31 @@ type worker struct {
 	logger.LogContext
 
 	ctx        context.Context
+	stop       context.Context
 	logContext logger.LogContext
 	pool       *pool
+	number     int
 	workqueue  workqueue.RateLimitingInterface
 }
 
-func newWorker(p *pool, number int) *worker {
+func newWorker(p *pool, stop context.Context, number int) *worker {
 	lgr := p.NewContext("worker", strconv.Itoa(number))
 
 	return &worker{
 		LogContext: lgr,
 
 		ctx:        p.ctx,
+		stop:       stop,
 		logContext: lgr,
 		pool:       p,
+		number:     number,
 		workqueue:  p.workqueue,
 	}
 }
 
 func (w *worker) Run() {
 	w.Infof("starting worker")
-	for w.processNextWorkItem() {
+	for w.stop.Err() == nil && w.processNextWorkItem() {
 	}
 	w.Infof("exit worker")
 }
@@ -100,6 +104,8 @@ func (w *worker) processNextWorkItem() bool {
 	}
 
 	defer w.loggerForKey(key)()
+	w.pool.workerStarted(w.number, key)
+	defer w.pool.workerDone(w.number)
 
 	cmd, rkey, r, err := w.pool.controller.DecodeKey(key)
 
@@ -107,6 +113,7 @@ func (w *worker) processNextWorkItem() bool {
 		// The resources may no longer exist, in which case we stop processing.
 		if !errors.IsNotFound(err) {
 			w.Errorf("error syncing '%s': %s", key, err)
+			w.pool.limiter.SetError(key, err)
 			w.workqueue.AddRateLimited(key)
 			return true
 		}
@@ -127,7 +134,9 @@ func (w *worker) processNextWorkItem() bool {
 			return true
 		}
 		for _, reconciler := range reconcilers {
+			start := time.Now()
 			status := catch(func() reconcile.Status { return reconciler.Command(w, cmd) })
+			w.pool.controller.recordReconcile(key, reconciler, start, status)
 			if !status.Completed {
 				ok = false
 			}
@@ -169,9 +178,18 @@ func (w *worker) processNextWorkItem() bool {
 			}
 		}
 
+		combined := reconcile.Status{Completed: true, Interval: -1}
 		for _, reconciler := range reconcilers {
+			start := time.Now()
 			status := catch(f(reconciler))
+			w.pool.controller.recordReconcile(key, reconciler, start, status)
 			w.pool.controller.requestHandled(w, reconciler, *rkey)
+			if !status.Completed {
+				combined.Completed = false
+			}
+			if status.Error != nil {
+				combined.Error = status.Error
+			}
 			if !status.Completed {
 				ok = false
 			}
@@ -186,10 +204,14 @@ func (w *worker) processNextWorkItem() bool {
 			}
 			updateSchedule(&reschedule, status.Interval)
 		}
+		if !deleted && len(reconcilers) > 0 && w.pool.Owning().GroupKind() == rkey.GroupKind() {
+			w.pool.controller.updateReconcileCondition(w, r, combined)
+		}
 	}
 	if err != nil {
 		if ok && reschedule < 0 {
 			w.Warnf("add rate limited because of problem: %s", err)
+			w.pool.limiter.SetError(obj, err)
 			// valid resources, but resources not ready yet (required state for reconciliation/deletion not yet) reached, re-add to the queue rate-limited
 			w.workqueue.AddRateLimited(obj)
 		} else {
@@ -176,9 +176,22 @@ func NewControllerManager(ctx context.Context, def Definition) (*ControllerManag
 	}
 
 	cm.clusters = clusters
+	config.RegisterValueResolver("secret", newSecretValueResolver(clusters, cfg.SecretCluster))
+	// secret references can only be resolved now, evaluate the options again
+	// for the option sources to see the resolved values.
+	if err := maincfg.Reevaluate(); err != nil {
+		return nil, err
+	}
+	if cfg.ValueRefreshPeriod > 0 {
+		config.WatchValues(ctx, lgr, maincfg, cfg.ValueRefreshPeriod)
+	}
+
 	list := []resources.Cluster{}
 	for c := range clusters.Names() {
 		list = append(list, clusters.GetCluster(c))
+		if err := cluster.RegisterReadinessChecks(clusters.GetCluster(c)); err != nil {
+			return nil, err
+		}
 	}
 	cm.migrations = resources.ClusterIdMigrationFor(list...)
 	cm.gkMigrations = resources.GroupKindMigrationFor(def.GroupKindMigrations()...)
@@ -23,6 +23,9 @@ type Definition interface {
 	ClusterDefinitions() cluster.Definitions
 	ExtendConfig(cfg *configmain.Config)
 	GroupKindMigrations() []schema.GroupKind
+	// GetEnvironmentPrefix provides the prefix for the
+	// environment variables used to set options
+	GetEnvironmentPrefix() string
 }
 
 type _Definition struct {
@@ -31,6 +34,7 @@ type _Definition struct {
 	extensions   extension.ExtensionDefinitions
 	cluster_defs cluster.Definitions
 	gkMigrations []schema.GroupKind
+	envPrefix    string
 }
 
 func (this *_Definition) GetName() string {
@@ -76,6 +80,10 @@ func (this *_Definition) GroupKindMigrations() []schema.GroupKind {
 	return this.gkMigrations
 }
 
+func (this *_Definition) GetEnvironmentPrefix() string {
+	return this.envPrefix
+}
+
 func DefaultDefinition(name, desc string) Definition {
 	return Configure(name, desc, nil).ByDefault().Definition()
 }
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/conversion"
 
@@ -18,6 +19,9 @@ import (
 )
 
 func Convert_v1alpha1_ExampleSpec_To_example_ExampleSpec(in *ExampleSpec, out *example.ExampleSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ExampleSpec_To_example_ExampleSpec(in, out, s); err != nil {
+		return err
+	}
 	if in.Port > 0 {
 		out.URL = fmt.Sprintf("%s://%s:%d/%s", in.URLScheme, in.Hostname, in.Port, in.Path)
 	} else {
@@ -27,11 +31,14 @@ func Convert_v1alpha1_ExampleSpec_To_example_ExampleSpec(in *ExampleSpec, out *e
 }
 
 func Convert_example_ExampleSpec_To_v1alpha1_ExampleSpec(in *example.ExampleSpec, out *ExampleSpec, s conversion.Scope) error {
+	if err := autoConvert_example_ExampleSpec_To_v1alpha1_ExampleSpec(in, out, s); err != nil {
+		return err
+	}
 	u, err := url.Parse(in.URL)
 	if err == nil {
 		out.URLScheme = u.Scheme
 		out.Hostname = u.Hostname()
-		out.Path = u.Path
+		out.Path = strings.TrimPrefix(u.Path, "/")
 		out.Port, err = strconv.Atoi(u.Port())
 		if err != nil {
 			out.Port = 0
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/gardener/controller-manager-library/pkg/config"
@@ -281,6 +282,11 @@ type ElementOptions interface {
 	GetStringArrayOption(name string) ([]string, error)
 	GetIntOption(name string) (int, error)
 	GetDurationOption(name string) (time.Duration, error)
+	GetStringMapOption(name string) (map[string]string, error)
+	GetFloatOption(name string) (float64, error)
+	GetQuantityOption(name string) (resource.Quantity, error)
+	GetIntSliceOption(name string) ([]int, error)
+	GetDurationSliceOption(name string) ([]time.Duration, error)
 }
 
 type ElementBase interface {
@@ -384,6 +390,46 @@ func (this *elementBase) GetDurationOption(name string) (time.Duration, error) {
 	return opt.DurationValue(), nil
 }
 
+func (this *elementBase) GetStringMapOption(name string) (map[string]string, error) {
+	opt, err := this.GetOption(name)
+	if err != nil {
+		return map[string]string{}, err
+	}
+	return opt.StringMap(), nil
+}
+
+func (this *elementBase) GetFloatOption(name string) (float64, error) {
+	opt, err := this.GetOption(name)
+	if err != nil {
+		return 0, err
+	}
+	return opt.FloatValue(), nil
+}
+
+func (this *elementBase) GetQuantityOption(name string) (resource.Quantity, error) {
+	opt, err := this.GetOption(name)
+	if err != nil {
+		return resource.Quantity{}, err
+	}
+	return opt.QuantityValue(), nil
+}
+
+func (this *elementBase) GetIntSliceOption(name string) ([]int, error) {
+	opt, err := this.GetOption(name)
+	if err != nil {
+		return []int{}, err
+	}
+	return opt.IntSlice(), nil
+}
+
+func (this *elementBase) GetDurationSliceOption(name string) ([]time.Duration, error) {
+	opt, err := this.GetOption(name)
+	if err != nil {
+		return []time.Duration{}, err
+	}
+	return opt.DurationSlice(), nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 type OptionDefinition interface {
@@ -109,6 +109,9 @@ func NewCommand(ctx context.Context, use, short, long string, def Definition) *c
 	ctx, cfg := configmain.WithConfig(ctx, nil)
 	def.ExtendConfig(cfg)
 	fileName := ""
+	validateOnly := false
+	objectName := ""
+	objectNamespace := ""
 	cmd := &cobra.Command{
 		Use:     use,
 		Short:   short,
@@ -116,11 +119,50 @@ func NewCommand(ctx context.Context, use, short, long string, def Definition) *c
 		Version: Version,
 	}
 	cmd.RunE = func(_ *cobra.Command, _ []string) error {
+		sources := optionSources{}
+		sources.record(cmd.Flags(), SOURCE_FLAG)
+		if err := config.MergeEnvironment(def.GetEnvironmentPrefix(), cmd.Flags(), false); err != nil {
+			return err
+		}
+		sources.record(cmd.Flags(), SOURCE_ENVIRONMENT)
+		if validateOnly {
+			return validateConfig(ctx, cmd, fileName, objectNamespace, objectName, cfg, sources)
+		}
+		var reloader *config.ConfigReloader
 		if fileName != "" {
 			logger.Infof("reading config from file %q", fileName)
+			if err := config.ValidateConfigFile(fileName, cmd.Flags()).Error(); err != nil {
+				return err
+			}
+			var err error
+			reloader, err = config.NewConfigReloader(logger.New(), fileName, cfg, cmd.Flags())
+			if err != nil {
+				return err
+			}
 			if err := config.MergeConfigFile(fileName, cmd.Flags(), false); err != nil {
 				return fmt.Errorf("invalid config file %q; %s", fileName, err)
 			}
+			sources.record(cmd.Flags(), SOURCE_CONFIG_FILE)
+		}
+		if objectName != "" {
+			object, err := readConfigObject(ctx, cmd.Flags(), cfg, objectNamespace, objectName, sources)
+			if err != nil {
+				return err
+			}
+			if reloader != nil {
+				// options set by the object keep their values on reload
+				reloader.Fix(sources.names(SOURCE_CONFIG_OBJECT)...)
+			}
+			err = cfg.Evaluate()
+			object.updateStatus(ctx, err, sources, cfg)
+			if err != nil {
+				return err
+			}
+		}
+		if reloader != nil {
+			if err := reloader.Watch(ctx); err != nil {
+				logger.Warnf("cannot watch config file %q: %s", fileName, err)
+			}
 		}
 		if err := runCM(ctx, def); err != nil {
 			fmt.Fprintf(os.Stderr, "%s\n", err)
@@ -131,6 +173,71 @@ func NewCommand(ctx context.Context, use, short, long string, def Definition) *c
 
 	cfg.AddToCommand(cmd)
 	cmd.Flags().StringVarP(&fileName, "config", "", "", "config file")
+	cmd.Flags().StringVarP(&objectName, "config-object", "", "", "name of a ControllerManagerConfiguration object in the default cluster used as config source")
+	cmd.Flags().StringVarP(&objectNamespace, "config-object-namespace", "", "", "namespace of the config object (default: the namespace option)")
+	cmd.Flags().BoolVarP(&validateOnly, "validate-config", "", false, "validate the config and exit")
+	cmd.AddCommand(newOptionsCommand(use, cfg))
+	config.AddEnvironmentUsage(def.GetEnvironmentPrefix(), cmd.PersistentFlags())
+	config.AddEnvironmentUsage(def.GetEnvironmentPrefix(), cmd.Flags())
+	return cmd
+}
+
+// validateConfig validates the config file, the config object and the
+// resulting option values and reports all problems found without starting
+// the controller manager.
+func validateConfig(ctx context.Context, cmd *cobra.Command, fileName, objectNamespace, objectName string, cfg *configmain.Config, sources optionSources) error {
+	validation := &config.ConfigValidation{}
+	if fileName != "" {
+		validation = config.ValidateConfigFile(fileName, cmd.Flags())
+		if validation.Error() == nil {
+			if err := config.MergeConfigFile(fileName, cmd.Flags(), false); err != nil {
+				return fmt.Errorf("invalid config file %q; %s", fileName, err)
+			}
+			sources.record(cmd.Flags(), SOURCE_CONFIG_FILE)
+		}
+	}
+	if validation.Error() == nil && objectName != "" {
+		if _, err := readConfigObject(ctx, cmd.Flags(), cfg, objectNamespace, objectName, sources); err != nil {
+			return err
+		}
+	}
+	if validation.Error() == nil {
+		validation.ValidateOptions(cfg)
+	}
+	if err := validation.Error(); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "config is valid\n")
+	return nil
+}
+
+// newOptionsCommand creates a command generating a JSON schema for
+// the config file or markdown reference documentation for all options.
+func newOptionsCommand(use string, cfg *configmain.Config) *cobra.Command {
+	format := ""
+	cmd := &cobra.Command{
+		Use:   "options",
+		Short: "describe the supported options",
+		Long:  "generate a JSON schema for the config file (format schema) or markdown reference documentation (format markdown) for all supported options",
+		Args:  cobra.NoArgs,
+	}
+	cmd.RunE = func(_ *cobra.Command, _ []string) error {
+		infos := config.DescribeOptions(cfg)
+		switch format {
+		case "schema":
+			data, err := config.JSONSchema(infos)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", data)
+		case "markdown":
+			fmt.Fprint(cmd.OutOrStdout(), config.MarkdownReference(fmt.Sprintf("Options of %s", strings.Split(use, " ")[0]), infos))
+		default:
+			return fmt.Errorf("invalid format %q (possible values: schema, markdown)", format)
+		}
+		return nil
+	}
+	cmd.Flags().StringVarP(&format, "format", "", "markdown", "output format (schema or markdown)")
 	return cmd
 }
 
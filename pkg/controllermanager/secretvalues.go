@@ -0,0 +1,41 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package controllermanager
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gardener/controller-manager-library/pkg/config"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// newSecretValueResolver creates a resolver for option values given as
+// secret://namespace/name#key reading the secret from the given cluster.
+func newSecretValueResolver(clusters cluster.Clusters, name string) config.ValueResolver {
+	return config.ValueResolverFunc(func(ref *url.URL) (string, error) {
+		c := clusters.GetCluster(name)
+		if c == nil {
+			return "", fmt.Errorf("cluster %q not available", name)
+		}
+		secret := strings.Trim(ref.Path, "/")
+		if ref.Host == "" || secret == "" || strings.Contains(secret, "/") || ref.Fragment == "" {
+			return "", fmt.Errorf("invalid secret reference (expected secret://<namespace>/<name>#<key>)")
+		}
+		s, err := resources.GetSecret(c, ref.Host, secret)
+		if err != nil {
+			return "", err
+		}
+		data, ok := s.GetData()[ref.Fragment]
+		if !ok {
+			return "", fmt.Errorf("key %q not found in secret %s/%s", ref.Fragment, ref.Host, secret)
+		}
+		return string(data), nil
+	})
+}
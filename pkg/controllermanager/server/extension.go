@@ -17,6 +17,7 @@ import (
 	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/server/config"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
 	"github.com/gardener/controller-manager-library/pkg/ctxutil"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 	"k8s.io/apimachinery/pkg/util/runtime"
 )
@@ -153,7 +154,7 @@ func (this *Extension) RequiredClusterIds(_ cluster.Clusters) utils.StringSet {
 }
 
 func (this *Extension) Setup(_ context.Context) error {
-	ready.Register(this)
+	ready.RegisterCheck("servers", this)
 	return nil
 }
 
@@ -161,6 +162,14 @@ func (this *Extension) IsReady() bool {
 	return this.ready
 }
 
+// Check reports whether all servers are started.
+func (this *Extension) Check() healthz.Status {
+	if this.ready {
+		return healthz.NewStatus("servers", "")
+	}
+	return healthz.NewStatus("servers", "servers not started")
+}
+
 func (this *Extension) Start(ctx context.Context) error {
 	for _, def := range this.registrations {
 		lines := strings.Split(def.String(), "\n")
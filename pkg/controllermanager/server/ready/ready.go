@@ -8,7 +8,6 @@
 package ready
 
 import (
-	"io"
 	"net/http"
 
 	"github.com/gardener/controller-manager-library/pkg/server"
@@ -16,16 +15,29 @@ import (
 
 func init() {
 	server.Register("/ready", Ready)
+	server.Register("/readyz", Readyz)
+	server.Register("/readyz/", Readyz)
+	server.Register("/startupz", Startupz)
+	server.Register("/startupz/", Startupz)
 }
 
 // Ready is a HTTP handler for the /ready endpoint which responses with 200 OK status code
 // if server is ready and with 500 Internal Server error status code otherwise.
-func Ready(w http.ResponseWriter, _ *http.Request) {
-	ok, info := ReadyInfo()
-	if ok {
-		w.WriteHeader(http.StatusOK)
-	} else {
-		w.WriteHeader(http.StatusInternalServerError)
-	}
-	_, _ = io.WriteString(w, info+"\n")
+func Ready(w http.ResponseWriter, r *http.Request) {
+	ReadyChecks.Handler("/ready").ServeHTTP(w, r)
+}
+
+// Readyz is a HTTP handler for the /readyz endpoint. It works like Ready,
+// additionally dedicated checks can be executed with /readyz/<check>.
+// With the query parameter verbose the status of all checks is reported as JSON,
+// and checks can be excluded with the query parameter exclude.
+func Readyz(w http.ResponseWriter, r *http.Request) {
+	ReadyChecks.Handler("/readyz").ServeHTTP(w, r)
+}
+
+// Startupz is a HTTP handler for the /startupz endpoint reporting the startup
+// progress, for example the sync state of the informer caches and the state of
+// the controllers. It supports the same sub paths and query parameters as Readyz.
+func Startupz(w http.ResponseWriter, r *http.Request) {
+	StartupChecks.Handler("/startupz").ServeHTTP(w, r)
 }
@@ -10,37 +10,70 @@ package ready
 import (
 	"fmt"
 	"sync"
+
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
 )
 
 type ReadyReporter interface {
 	IsReady() bool
 }
 
+// ReadyChecks are the checks served by the /readyz endpoint.
+var ReadyChecks = healthz.NewChecks("readyz", "no ready reporter configured")
+
 var lock sync.Mutex
-var reporters []ReadyReporter
+var unnamed int
 
+// Register registers a ready reporter without a dedicated name.
+// If the reporter implements the healthz.Checker interface, its
+// status is used for the readiness check.
 func Register(reporter ReadyReporter) {
 	lock.Lock()
 	defer lock.Unlock()
 
-	reporters = append(reporters, reporter)
+	unnamed++
+	RegisterReporter(fmt.Sprintf("reporter-%d", unnamed), reporter)
 }
 
-func ReadyInfo() (bool, string) {
-	lock.Lock()
-	defer lock.Unlock()
-
-	if len(reporters) == 0 {
-		return false, "no ready reporter configured"
+// RegisterReporter registers a named readiness check for a ready reporter.
+// If the reporter implements the healthz.Checker interface, its
+// status is used for the readiness check.
+func RegisterReporter(name string, reporter ReadyReporter) {
+	if c, ok := reporter.(healthz.Checker); ok {
+		ReadyChecks.Register(name, c)
+		return
 	}
+	ReadyChecks.Register(name, healthz.CheckerFunc(func() healthz.Status {
+		if reporter.IsReady() {
+			return healthz.NewStatus(name, "")
+		}
+		return healthz.NewStatus(name, "not ready")
+	}))
+}
+
+// RegisterCheck registers a named readiness check.
+func RegisterCheck(name string, checker healthz.Checker) {
+	ReadyChecks.Register(name, checker)
+}
+
+// Unregister removes a named readiness check.
+func Unregister(name string) {
+	ReadyChecks.Unregister(name)
+}
+
+func ReadyInfo() (bool, string) {
+	report := ReadyChecks.Execute(nil)
 	ready_cnt := 0
 	notready_cnt := 0
-	for _, r := range reporters {
-		if r.IsReady() {
+	for _, c := range report.Checks {
+		if c.Healthy {
 			ready_cnt++
 		} else {
 			notready_cnt++
 		}
 	}
+	if len(ReadyChecks.Names()) == 0 {
+		return false, "no ready reporter configured"
+	}
 	return notready_cnt == 0, fmt.Sprintf("ready: %d, not ready %d", ready_cnt, notready_cnt)
 }
@@ -0,0 +1,25 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package ready
+
+import (
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
+)
+
+// StartupChecks are the checks served by the /startupz endpoint.
+var StartupChecks = healthz.NewChecks("startupz", "")
+
+// RegisterStartupCheck registers a named startup check.
+func RegisterStartupCheck(name string, checker healthz.Checker) {
+	StartupChecks.Register(name, checker)
+}
+
+// UnregisterStartupCheck removes a named startup check.
+func UnregisterStartupCheck(name string) {
+	StartupChecks.Unregister(name)
+}
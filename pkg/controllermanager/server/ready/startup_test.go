@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package ready
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
+)
+
+func startupz(target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	Startupz(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+var _ = Describe("startupz", func() {
+	synced := false
+
+	BeforeEach(func() {
+		synced = false
+		RegisterStartupCheck("informers/default", healthz.CheckerFunc(func() healthz.Status {
+			if synced {
+				return healthz.NewStatus("", "")
+			}
+			return healthz.NewStatus("", "1 of 1 informers not synced (1 timed out)")
+		}))
+		RegisterStartupCheck("controller/test", healthz.CheckerFunc(func() healthz.Status {
+			return healthz.Status{Healthy: true, Reason: "skipped (0 events dropped): cache not synced"}
+		}))
+	})
+
+	AfterEach(func() {
+		UnregisterStartupCheck("informers/default")
+		UnregisterStartupCheck("controller/test")
+	})
+
+	It("reports the startup progress", func() {
+		w := startupz("/startupz")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(w.Body.String()).To(Equal("[+]controller/test ok\n" +
+			"[-]informers/default failed: 1 of 1 informers not synced (1 timed out)\n" +
+			"startupz check failed\n"))
+
+		synced = true
+		w = startupz("/startupz")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(HaveSuffix("startupz check passed\n"))
+	})
+
+	It("serves dedicated checks, exclusions and verbose output", func() {
+		Expect(startupz("/startupz/controller/test").Code).To(Equal(http.StatusOK))
+		Expect(startupz("/startupz?exclude=informers/default").Code).To(Equal(http.StatusOK))
+		Expect(startupz("/startupz/unknown").Code).To(Equal(http.StatusNotFound))
+
+		w := startupz("/startupz/controller/test?verbose")
+		Expect(w.Body.String()).To(ContainSubstring(`"reason": "skipped (0 events dropped): cache not synced"`))
+	})
+
+	It("keeps the startup checks separate from the readiness checks", func() {
+		Expect(StartupChecks.Names()).To(Equal([]string{"controller/test", "informers/default"}))
+		Expect(ReadyChecks.Get("informers/default")).To(BeNil())
+	})
+})
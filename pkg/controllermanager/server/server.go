@@ -10,6 +10,7 @@ package server
 import (
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/gardener/controller-manager-library/pkg/certmgmt"
 	"github.com/gardener/controller-manager-library/pkg/certmgmt/secret"
@@ -22,6 +23,7 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
 	"github.com/gardener/controller-manager-library/pkg/server"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 )
 
@@ -39,7 +41,7 @@ type httpserver struct {
 
 	config *ServerConfig
 
-	readyHandlers []ready.ReadyReporter
+	readyHandlers map[string]ready.ReadyReporter
 	ready         bool
 }
 
@@ -51,6 +53,8 @@ func NewServer(env Environment, def Definition, cmp mappings.Definition) (*https
 		config:     options,
 		env:        env,
 		handlers:   map[string]handler.Interface{},
+
+		readyHandlers: map[string]ready.ReadyReporter{},
 	}
 
 	this.ElementBase = extension.NewElementBase(env.GetContext(), ctx_server, this, def.Name(), SERVER_SET_PREFIX, options)
@@ -136,15 +140,31 @@ func (this *httpserver) RegisterHandler(pattern string, handler http.Handler) {
 }
 
 func (this *httpserver) IsReady() bool {
-	if !this.ready {
-		for _, r := range this.readyHandlers {
-			if !r.IsReady() {
-				return false
-			}
+	return this.Check().Healthy
+}
+
+// Check reports the readiness of the server handlers.
+func (this *httpserver) Check() healthz.Status {
+	if this.ready {
+		return healthz.NewStatus(this.GetName(), "")
+	}
+	status := healthz.NewStatus(this.GetName(), "")
+	names := utils.StringKeySet(this.readyHandlers).AsArray()
+	sort.Strings(names)
+	for _, n := range names {
+		h := healthz.NewStatus(n, "")
+		if !this.readyHandlers[n].IsReady() {
+			h = healthz.NewStatus(n, "handler not ready")
+			status.Healthy = false
+			status.Reason = "handler " + n + " not ready"
 		}
+		status.Components = append(status.Components, h)
+	}
+	if status.Healthy {
 		this.ready = true
+		status.Components = nil
 	}
-	return this.ready
+	return status
 }
 
 func (this *httpserver) handleSetup() error {
@@ -163,11 +183,11 @@ func (this *httpserver) handleSetup() error {
 			}
 		}
 		if r, ok := h.(handler.ReadyInterface); ok {
-			this.readyHandlers = append(this.readyHandlers, r)
+			this.readyHandlers[n] = r
 		}
 	}
 	if len(this.readyHandlers) > 0 {
-		ready.Register(this)
+		ready.RegisterCheck("server/"+this.GetName(), this)
 	} else {
 		this.ready = true
 	}
@@ -196,6 +216,13 @@ func (this *httpserver) Start() error {
 			}
 		}
 	}
+	if this.certificate != nil {
+		clientAuth, err := this.config.CertConfig.ClientAuthConfig()
+		if err != nil {
+			return err
+		}
+		this.server.SetClientAuth(clientAuth)
+	}
 	this.Infof("starting %s server %s on port %d", this.definition.Kind(), this.definition.Name(), this.config.ServerPort)
 	this.server.Start(this.certificate, "", this.config.ServerPort, tweak...)
 	return nil
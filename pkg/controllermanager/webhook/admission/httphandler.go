@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
+	"github.com/gardener/controller-manager-library/pkg/server"
 
 	admissionv1beta1 "k8s.io/api/admission/v1beta1"
 )
@@ -41,6 +42,9 @@ func (this *HTTPHandler) Webhook() Interface {
 func (this *HTTPHandler) handle(req Request) Response {
 	name := resources.NewObjectName(req.Namespace, req.Name)
 	logctx := this.NewContext("object", name.String())
+	if req.Client != nil {
+		logctx = logctx.NewContext("client", req.Client.String())
+	}
 	logctx.Infof("handle request for %s", req.Resource)
 	resp := this.webhook.Handle(logctx, req)
 	if err := resp.Complete(req); err != nil {
@@ -79,7 +83,7 @@ func (this *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	req := Request{}
+	req := Request{Client: server.GetClientIdentity(r)}
 	ar := admissionv1beta1.AdmissionReview{
 		// avoid an extra copy
 		Request: &req.AdmissionRequest,
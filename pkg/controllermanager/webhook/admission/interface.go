@@ -17,6 +17,7 @@ import (
 
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/webhook"
 	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/server"
 )
 
 var (
@@ -30,6 +31,9 @@ var (
 // (e.g. Get, Create, etc), and the object itself.
 type Request struct {
 	admissionv1beta1.AdmissionRequest
+	// Client is the identity of the client authenticated by a verified
+	// client certificate (nil if client certificates are not verified).
+	Client *server.ClientIdentity
 }
 
 // Response is the output of an admission handler.
@@ -0,0 +1,90 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package conversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+const (
+	ContentTypeJSON     = runtime.ContentTypeJSON
+	ContentTypeYAML     = runtime.ContentTypeYAML
+	ContentTypeProtobuf = runtime.ContentTypeProtobuf
+)
+
+// ContentTypes are the supported content types for conversion reviews.
+var ContentTypes = []string{ContentTypeJSON, ContentTypeYAML, ContentTypeProtobuf}
+
+// wireCodecs are used to transcode conversion reviews given in other
+// content types than JSON. The conversion reviews are handled in JSON
+// form with the api types, the apiextensions types support protobuf.
+var wireCodecs serializer.CodecFactory
+
+func init() {
+	wireScheme := runtime.NewScheme()
+	utilruntime.Must(apiextv1.AddToScheme(wireScheme))
+	utilruntime.Must(apiextv1beta1.AddToScheme(wireScheme))
+	wireCodecs = serializer.NewCodecFactory(wireScheme)
+}
+
+// parseMediaType parses a content type and checks whether it is supported.
+func parseMediaType(contentType string) (string, error) {
+	t, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("invalid content type %q: %s", contentType, err)
+	}
+	for _, s := range ContentTypes {
+		if t == s {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("contentType=%s, expected one of %v", contentType, ContentTypes)
+}
+
+// toJSON transcodes a conversion review of the given media type to JSON.
+func toJSON(mediaType string, data []byte) ([]byte, error) {
+	if mediaType == ContentTypeJSON {
+		return data, nil
+	}
+	obj, gvk, err := wireCodecs.UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(*gvk)
+	return json.Marshal(obj)
+}
+
+// fromJSON transcodes a conversion review given in JSON to the given media type.
+func fromJSON(mediaType string, data []byte) ([]byte, error) {
+	if mediaType == ContentTypeJSON {
+		return data, nil
+	}
+	info, ok := runtime.SerializerInfoForMediaType(wireCodecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		return nil, fmt.Errorf("no serializer for %s", mediaType)
+	}
+	obj, gvk, err := wireCodecs.UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(*gvk)
+	buf := &bytes.Buffer{}
+	if err := info.Serializer.Encode(obj, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -23,6 +23,8 @@ type Definition interface {
 type _Definition struct {
 	factory   ConversionHandlerType
 	validator webhook.WebhookValidator
+	parallel  int
+	selfTest  bool
 }
 
 var _ webhook.WebhookHandler = (*_Definition)(nil)
@@ -37,7 +39,14 @@ func (this *_Definition) GetHTTPHandler(wh webhook.Interface) (http.Handler, err
 	if err != nil {
 		return nil, err
 	}
-	return &HTTPHandler{webhook: h, LogContext: wh}, nil
+	handler := NewHTTPHandler(wh, wh.GetName(), h)
+	handler.parallel = this.parallel
+	if this.selfTest {
+		if err := selfTest(wh, handler); err != nil {
+			return nil, err
+		}
+	}
+	return handler, nil
 }
 
 func (this *_Definition) String() string {
@@ -66,5 +75,20 @@ func (this configuration) CreateHandler() webhook.WebhookHandler {
 }
 
 func Conversion(htype ConversionHandlerType) *configuration {
-	return &configuration{_Definition{htype, nil}}
+	return &configuration{_Definition{factory: htype}}
+}
+
+// Parallel converts the objects of a conversion request with the given
+// number of workers in parallel.
+func (this *configuration) Parallel(workers int) *configuration {
+	this.settings.parallel = workers
+	return this
+}
+
+// WithSelfTest enables a conversion round-trip test executed for all
+// versions of the webhook resources on startup. The webhook fails to
+// start if a round trip is lossy.
+func (this *configuration) WithSelfTest() *configuration {
+	this.settings.selfTest = true
+	return this
 }
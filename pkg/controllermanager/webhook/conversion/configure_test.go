@@ -0,0 +1,51 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package conversion
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/webhook"
+)
+
+type testWebhook struct {
+	webhook.Interface
+	schemeRequests int
+}
+
+func (this *testWebhook) GetName() string {
+	return "test"
+}
+
+func (this *testWebhook) GetScheme() *runtime.Scheme {
+	this.schemeRequests++
+	return nil
+}
+
+var _ = Describe("conversion definition", func() {
+	factory := func(webhook.Interface) (Interface, error) {
+		return &testConverter{}, nil
+	}
+
+	It("runs no self-test by default", func() {
+		wh := &testWebhook{}
+		h, err := Conversion(factory).Parallel(3).CreateHandler().(*_Definition).GetHTTPHandler(wh)
+		Expect(err).To(Succeed())
+		Expect(h.(*HTTPHandler).parallel).To(Equal(3))
+		Expect(wh.schemeRequests).To(Equal(0))
+	})
+
+	It("runs the self-test if enabled", func() {
+		wh := &testWebhook{}
+		_, err := Conversion(factory).WithSelfTest().CreateHandler().(*_Definition).GetHTTPHandler(wh)
+		Expect(err).To(Succeed())
+		Expect(wh.schemeRequests).To(Equal(1))
+	})
+})
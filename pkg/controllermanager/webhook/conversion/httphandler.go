@@ -7,10 +7,15 @@
 package conversion
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
 
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -18,55 +23,168 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/webhook/conversion/api"
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
+	"github.com/gardener/controller-manager-library/pkg/server"
 )
 
 var _ http.Handler = &HTTPHandler{}
 
+// maxReportedErrors limits the number of object errors
+// mentioned in the message of a conversion result.
+const maxReportedErrors = 3
+
 // HTTPHandler represents each individual webhook.
 type HTTPHandler struct {
 	// Handler actually processes a conversion review request,
 	webhook Interface
+	// name is the name of the webhook used for the metrics
+	name string
+	// parallel is the number of objects of a request converted in parallel.
+	parallel int
 
 	logger.LogContext
 }
 
+// NewHTTPHandler creates an HTTP handler serving conversion reviews
+// with the given conversion handler.
+func NewHTTPHandler(logger logger.LogContext, name string, webhook Interface) *HTTPHandler {
+	return &HTTPHandler{webhook: webhook, name: name, LogContext: logger}
+}
+
 func (this *HTTPHandler) Webhook() Interface {
 	return this.webhook
 }
 
 // handle processes ConversionReviewRequest.
-func (this *HTTPHandler) handle(req *Request) *Response {
+// All objects are converted, even if the conversion of some objects fails.
+// The failed objects are reported in the result of the response.
+func (this *HTTPHandler) handle(req *Request, client *server.ClientIdentity) *Response {
 	logctx := this.NewContext("conversion", req.DesiredAPIVersion)
+	if client != nil {
+		logctx = logctx.NewContext("client", client.String())
+	}
 	logctx.Infof("handle request for %d resources", len(req.Objects))
-	resp := &Response{
+
+	converted := make([]runtime.RawExtension, len(req.Objects))
+	errs := make([]error, len(req.Objects))
+	convert := func(i int) {
+		converted[i], errs[i] = this.convert(logctx, req.DesiredAPIVersion, req.Objects[i])
+	}
+
+	if this.parallel > 1 && len(req.Objects) > 1 {
+		workers := this.parallel
+		if workers > len(req.Objects) {
+			workers = len(req.Objects)
+		}
+		indices := make(chan int)
+		wg := sync.WaitGroup{}
+		wg.Add(workers)
+		for w := 0; w < workers; w++ {
+			go func() {
+				defer wg.Done()
+				for i := range indices {
+					convert(i)
+				}
+			}()
+		}
+		for i := range req.Objects {
+			indices <- i
+		}
+		close(indices)
+		wg.Wait()
+	} else {
+		for i := range req.Objects {
+			convert(i)
+		}
+	}
+
+	var causes []meta.StatusCause
+	var msgs []string
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		msg := err.Error()
+		if name := objectName(req.Objects[i]); name != "" {
+			msg = fmt.Sprintf("%s: %s", name, msg)
+		}
+		logctx.Errorf("conversion of object %d failed: %s", i, msg)
+		causes = append(causes, meta.StatusCause{
+			Type:    meta.CauseTypeFieldValueInvalid,
+			Field:   fmt.Sprintf("objects[%d]", i),
+			Message: msg,
+		})
+		if len(msgs) < maxReportedErrors {
+			msgs = append(msgs, msg)
+		}
+	}
+	convertedObjects.WithLabelValues(this.name, SUCCESS).Add(float64(len(req.Objects) - len(causes)))
+	if len(causes) > 0 {
+		convertedObjects.WithLabelValues(this.name, FAILURE).Add(float64(len(causes)))
+		if len(causes) > len(msgs) {
+			msgs = append(msgs, "...")
+		}
+		resp := ErrorResponse(req, http.StatusBadRequest,
+			fmt.Errorf("conversion failed for %d of %d objects: %s", len(causes), len(req.Objects), strings.Join(msgs, "; ")))
+		resp.Result.Reason = meta.StatusReasonInvalid
+		resp.Result.Details = &meta.StatusDetails{Causes: causes}
+		return resp
+	}
+	return &Response{
 		UID:              req.UID,
-		ConvertedObjects: make([]runtime.RawExtension, len(req.Objects)),
+		ConvertedObjects: converted,
 		Result: meta.Status{
-			Status:  SUCCESS,
-			Message: "",
-			Reason:  "",
-			Details: nil,
-			Code:    http.StatusOK,
+			Status: SUCCESS,
+			Code:   http.StatusOK,
 		},
 	}
-	for i, o := range req.Objects {
-		c, err := this.webhook.Handle(logctx, req.DesiredAPIVersion, o)
-		if err != nil {
-			return ErrorResponse(req, http.StatusBadRequest, err)
-		}
-		r, err := json.Marshal(c)
-		if err != nil {
-			return ErrorResponse(req, http.StatusUnprocessableEntity, err)
-		}
-		resp.ConvertedObjects[i] = runtime.RawExtension{
-			Raw: r,
+}
+
+// convert converts a single object and recovers from panics of the
+// conversion handler.
+func (this *HTTPHandler) convert(logctx logger.LogContext, version string, o runtime.RawExtension) (result runtime.RawExtension, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logctx.Errorf("conversion panicked: %v\n%s", r, debug.Stack())
+			err = fmt.Errorf("conversion panicked: %v", r)
 		}
+	}()
+	c, err := this.webhook.Handle(logctx, version, o)
+	if err != nil {
+		return result, err
+	}
+	r, err := json.Marshal(c)
+	if err != nil {
+		return result, err
 	}
-	return resp
+	return runtime.RawExtension{Raw: r}, nil
+}
+
+// objectName determines the name of an object to be converted used for
+// error reporting.
+func objectName(o runtime.RawExtension) string {
+	var obj meta.PartialObjectMetadata
+	if err := json.Unmarshal(o.Raw, &obj); err != nil || obj.Name == "" {
+		return ""
+	}
+	if obj.Namespace != "" {
+		return obj.Namespace + "/" + obj.Name
+	}
+	return obj.Name
 }
 
 func (this *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	obj, response := this.serveHTTP(r)
+	start := time.Now()
+	defer func() {
+		requestDuration.WithLabelValues(this.name).Observe(time.Since(start).Seconds())
+	}()
+	defer func() {
+		if p := recover(); p != nil {
+			this.Errorf("conversion request panicked: %v\n%s", p, debug.Stack())
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}()
+
+	obj, mediaType, response := this.serveHTTP(r)
 
 	if obj == nil {
 		w.WriteHeader(int(response.Result.Code))
@@ -82,42 +200,58 @@ func (this *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	err = json.NewEncoder(w).Encode(obj)
+	buf := &bytes.Buffer{}
+	err = json.NewEncoder(buf).Encode(obj)
+	if err != nil {
+		this.Errorf("failed to encode response: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	data, err := fromJSON(mediaType, buf.Bytes())
 	if err != nil {
+		this.Errorf("failed to encode response for %s: %s", mediaType, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", mediaType)
+	if _, err = w.Write(data); err != nil {
 		this.Errorf("failed to write response: %s", err)
 		return
 	}
 }
 
-func (this *HTTPHandler) serveHTTP(r *http.Request) (runtime.Object, *Response) {
+func (this *HTTPHandler) serveHTTP(r *http.Request) (runtime.Object, string, *Response) {
 	var body []byte
 	var err error
 
 	if r.Body == nil {
 		err = fmt.Errorf("request body is empty")
 		this.Error(err)
-		return nil, ErrorResponse(nil, http.StatusBadRequest, err)
+		return nil, "", ErrorResponse(nil, http.StatusBadRequest, err)
 	}
 	if body, err = io.ReadAll(r.Body); err != nil {
 		this.Error(err, "unable to read the body from the incoming request")
-		return nil, ErrorResponse(nil, http.StatusBadRequest, err)
+		return nil, "", ErrorResponse(nil, http.StatusBadRequest, err)
 	}
 
-	// verify the content type is accurate
+	// verify the content type is supported
 	contentType := r.Header.Get("Content-Type")
-	if contentType != "application/json" {
-		err = fmt.Errorf("contentType=%s, expected application/json", contentType)
+	mediaType, err := parseMediaType(contentType)
+	if err != nil {
 		this.Errorf("unable to process a request with an unknown content type: %s", contentType)
-		return nil, ErrorResponse(nil, http.StatusBadRequest, err)
+		return nil, "", ErrorResponse(nil, http.StatusUnsupportedMediaType, err)
+	}
+	if body, err = toJSON(mediaType, body); err != nil {
+		this.Errorf("unable to decode the %s request: %s", mediaType, err)
+		return nil, "", ErrorResponse(nil, http.StatusBadRequest, err)
 	}
 
 	versions := &resources.VersionedObjects{}
 
 	if err := reviewDecoder.DecodeInto(body, versions); err != nil {
-		this.Errorf("unable to decode the request", err)
-		return nil, ErrorResponse(nil, http.StatusBadRequest, err)
+		this.Errorf("unable to decode the request: %s", err)
+		return nil, "", ErrorResponse(nil, http.StatusBadRequest, err)
 	}
 
-	// TODO: add panic-recovery for Handle
-	return versions.First(), this.handle(versions.Last().(*api.ConversionReview).Request)
+	return versions.First(), mediaType, this.handle(versions.Last().(*api.ConversionReview).Request, server.GetClientIdentity(r))
 }
@@ -0,0 +1,212 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package conversion
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// testConverter sets the requested version. Objects named bad* fail,
+// objects named panic* panic.
+type testConverter struct {
+	delay time.Duration
+
+	lock    sync.Mutex
+	active  int
+	maximum int
+}
+
+func (this *testConverter) Handle(_ logger.LogContext, version string, o runtime.RawExtension) (runtime.Object, error) {
+	this.lock.Lock()
+	this.active++
+	if this.active > this.maximum {
+		this.maximum = this.active
+	}
+	this.lock.Unlock()
+	defer func() {
+		this.lock.Lock()
+		this.active--
+		this.lock.Unlock()
+	}()
+	time.Sleep(this.delay)
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(o.Raw); err != nil {
+		return nil, err
+	}
+	switch {
+	case strings.HasPrefix(obj.GetName(), "bad"):
+		return nil, fmt.Errorf("cannot convert")
+	case strings.HasPrefix(obj.GetName(), "panic"):
+		panic("conversion bug")
+	}
+	obj.SetAPIVersion(version)
+	return obj, nil
+}
+
+func (this *testConverter) Maximum() int {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	return this.maximum
+}
+
+func testObject(name string) runtime.RawExtension {
+	return runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"apiVersion":"example.com/v1","kind":"Thing","metadata":{"name":%q,"namespace":"default"}}`, name))}
+}
+
+func encodeReview(mediaType string, review *apiextv1.ConversionReview) []byte {
+	info, ok := runtime.SerializerInfoForMediaType(wireCodecs.SupportedMediaTypes(), mediaType)
+	Expect(ok).To(BeTrue())
+	buf := &bytes.Buffer{}
+	Expect(info.Serializer.Encode(review, buf)).To(Succeed())
+	return buf.Bytes()
+}
+
+func decodeReview(data []byte) *apiextv1.ConversionReview {
+	obj, _, err := wireCodecs.UniversalDeserializer().Decode(data, nil, nil)
+	Expect(err).To(Succeed())
+	review, ok := obj.(*apiextv1.ConversionReview)
+	Expect(ok).To(BeTrue())
+	return review
+}
+
+var _ = Describe("conversion HTTP handler", func() {
+	var (
+		converter *testConverter
+		handler   *HTTPHandler
+	)
+
+	BeforeEach(func() {
+		converter = &testConverter{}
+		handler = NewHTTPHandler(logger.New(), "test", converter)
+	})
+
+	request := func(mediaType string, names ...string) *apiextv1.ConversionReview {
+		review := &apiextv1.ConversionReview{
+			TypeMeta: meta.TypeMeta{APIVersion: apiextv1.SchemeGroupVersion.String(), Kind: "ConversionReview"},
+			Request:  &apiextv1.ConversionRequest{UID: types.UID("uid"), DesiredAPIVersion: "example.com/v2"},
+		}
+		for _, n := range names {
+			review.Request.Objects = append(review.Request.Objects, testObject(n))
+		}
+		req := httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(encodeReview(mediaType, review)))
+		req.Header.Set("Content-Type", mediaType)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("Content-Type")).To(Equal(mediaType))
+		return decodeReview(w.Body.Bytes())
+	}
+
+	names := func(review *apiextv1.ConversionReview) []string {
+		var result []string
+		for _, o := range review.Response.ConvertedObjects {
+			obj := &unstructured.Unstructured{}
+			Expect(obj.UnmarshalJSON(o.Raw)).To(Succeed())
+			Expect(obj.GetAPIVersion()).To(Equal("example.com/v2"))
+			result = append(result, obj.GetName())
+		}
+		return result
+	}
+
+	DescribeTable("answers in the content type of the request",
+		func(mediaType string) {
+			review := request(mediaType, "a", "b")
+			Expect(review.Response.UID).To(Equal(types.UID("uid")))
+			Expect(review.Response.Result.Status).To(Equal(SUCCESS))
+			Expect(names(review)).To(Equal([]string{"a", "b"}))
+		},
+		Entry("JSON", ContentTypeJSON),
+		Entry("YAML", ContentTypeYAML),
+		Entry("protobuf", ContentTypeProtobuf),
+	)
+
+	It("rejects unsupported content types", func() {
+		req := httptest.NewRequest(http.MethodPost, "/convert", strings.NewReader("data"))
+		req.Header.Set("Content-Type", "text/plain")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(w.Code).To(Equal(http.StatusUnsupportedMediaType))
+	})
+
+	It("rejects invalid requests", func() {
+		req := httptest.NewRequest(http.MethodPost, "/convert", strings.NewReader("{invalid"))
+		req.Header.Set("Content-Type", ContentTypeJSON)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("reports failed objects individually", func() {
+		review := request(ContentTypeJSON, "a", "bad1", "b", "bad2")
+		result := review.Response.Result
+		Expect(result.Status).To(Equal(FAILURE))
+		Expect(result.Code).To(Equal(int32(http.StatusBadRequest)))
+		Expect(result.Reason).To(Equal(meta.StatusReasonInvalid))
+		Expect(result.Message).To(Equal("conversion failed for 2 of 4 objects: default/bad1: cannot convert; default/bad2: cannot convert"))
+		Expect(result.Details.Causes).To(Equal([]meta.StatusCause{
+			{Type: meta.CauseTypeFieldValueInvalid, Field: "objects[1]", Message: "default/bad1: cannot convert"},
+			{Type: meta.CauseTypeFieldValueInvalid, Field: "objects[3]", Message: "default/bad2: cannot convert"},
+		}))
+		Expect(review.Response.ConvertedObjects).To(BeEmpty())
+	})
+
+	It("limits the errors mentioned in the message", func() {
+		review := request(ContentTypeJSON, "bad1", "bad2", "bad3", "bad4", "bad5")
+		result := review.Response.Result
+		Expect(result.Message).To(HavePrefix("conversion failed for 5 of 5 objects: "))
+		Expect(result.Message).To(HaveSuffix("default/bad3: cannot convert; ..."))
+		Expect(result.Details.Causes).To(HaveLen(5))
+	})
+
+	It("recovers from panics of the conversion", func() {
+		review := request(ContentTypeJSON, "a", "panic1")
+		result := review.Response.Result
+		Expect(result.Status).To(Equal(FAILURE))
+		Expect(result.Details.Causes).To(Equal([]meta.StatusCause{
+			{Type: meta.CauseTypeFieldValueInvalid, Field: "objects[1]", Message: "default/panic1: conversion panicked: conversion bug"},
+		}))
+	})
+
+	It("converts the objects in parallel keeping their order", func() {
+		converter.delay = 20 * time.Millisecond
+		handler.parallel = 4
+		var list []string
+		for i := 0; i < 12; i++ {
+			list = append(list, fmt.Sprintf("o%02d", i))
+		}
+		review := request(ContentTypeJSON, list...)
+		Expect(review.Response.Result.Status).To(Equal(SUCCESS))
+		Expect(names(review)).To(Equal(list))
+		Expect(converter.Maximum()).To(BeNumerically(">", 1))
+		Expect(converter.Maximum()).To(BeNumerically("<=", 4))
+	})
+
+	It("converts sequentially by default", func() {
+		converter.delay = 5 * time.Millisecond
+		review := request(ContentTypeJSON, "a", "b", "c")
+		Expect(names(review)).To(Equal([]string{"a", "b", "c"}))
+		Expect(converter.Maximum()).To(Equal(1))
+	})
+})
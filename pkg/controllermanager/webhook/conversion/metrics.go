@@ -0,0 +1,32 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package conversion
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	convertedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "controllermanager",
+		Subsystem: "conversion_webhook",
+		Name:      "objects_total",
+		Help:      "Number of objects converted by a conversion webhook.",
+	}, []string{"webhook", "result"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "controllermanager",
+		Subsystem: "conversion_webhook",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of conversion review requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"webhook"})
+)
+
+func init() {
+	prometheus.MustRegister(convertedObjects, requestDuration)
+}
@@ -0,0 +1,346 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+// Package roundtrip provides a test helper checking the conversions of a
+// scheme used by a scheme based conversion webhook.
+// Fuzzed objects of every version of a group kind are converted with the
+// webhook HTTP handler to every other version and back. Fields differing
+// from the original object are reported as lossy.
+//
+// It can be used in regular go tests
+//
+//	func TestConversion(t *testing.T) {
+//		scheme := runtime.NewScheme()
+//		install.Install(scheme)
+//		roundtrip.New(scheme, example.Kind("Example")).Test(t)
+//	}
+//
+// or with the failures returned by Run in any other test framework.
+package roundtrip
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/randfill"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/webhook/conversion"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// DefaultIterations is the default number of fuzzed objects per version.
+const DefaultIterations = 20
+
+// Failure describes a failed or lossy conversion round trip.
+type Failure struct {
+	// Version is the version of the fuzzed object.
+	Version schema.GroupVersionKind
+	// Via is the version the object has been converted to and back.
+	Via schema.GroupVersionKind
+	// Fields are the paths of the fields lost by the round trip.
+	Fields []string
+	// Diff describes the difference between the original and the round-tripped object.
+	Diff string
+	// Err is set if a conversion failed.
+	Err error
+}
+
+func (this Failure) String() string {
+	if this.Err != nil {
+		return fmt.Sprintf("%s via %s: %s", this.Version.Version, this.Via.Version, this.Err)
+	}
+	return fmt.Sprintf("%s via %s is lossy for fields %s", this.Version.Version, this.Via.Version, strings.Join(this.Fields, ", "))
+}
+
+// Tester checks the conversion round trips for a group kind of a scheme.
+type Tester struct {
+	scheme     *runtime.Scheme
+	gk         schema.GroupKind
+	iterations int
+	seed       int64
+	funcs      []fuzzer.FuzzerFuncs
+	handler    conversion.Interface
+}
+
+// New creates a round trip tester for a group kind of a scheme
+// using the scheme based conversion.
+func New(scheme *runtime.Scheme, gk schema.GroupKind) *Tester {
+	return &Tester{
+		scheme:     scheme,
+		gk:         gk,
+		iterations: DefaultIterations,
+		seed:       1,
+		handler:    conversion.NewSchemeHandler(scheme),
+	}
+}
+
+// Iterations sets the number of fuzzed objects per version.
+func (this *Tester) Iterations(n int) *Tester {
+	this.iterations = n
+	return this
+}
+
+// Seed sets the seed for the random source of the fuzzer.
+func (this *Tester) Seed(seed int64) *Tester {
+	this.seed = seed
+	return this
+}
+
+// Funcs adds fuzzer functions, for example to restrict the values
+// of fields to those accepted by the API.
+func (this *Tester) Funcs(funcs ...fuzzer.FuzzerFuncs) *Tester {
+	this.funcs = append(this.funcs, funcs...)
+	return this
+}
+
+// Handler sets the conversion handler to test instead of the scheme
+// based conversion.
+func (this *Tester) Handler(h conversion.Interface) *Tester {
+	this.handler = h
+	return this
+}
+
+// Versions returns the external versions of the group kind known by the scheme.
+func (this *Tester) Versions() []schema.GroupVersionKind {
+	var versions []schema.GroupVersionKind
+	for gvk := range this.scheme.AllKnownTypes() {
+		if gvk.GroupKind() == this.gk && gvk.Version != runtime.APIVersionInternal {
+			versions = append(versions, gvk)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	return versions
+}
+
+// Test runs the round trips and reports every failure as test error.
+func (this *Tester) Test(t testing.TB) {
+	t.Helper()
+	if len(this.Versions()) < 2 {
+		t.Errorf("scheme contains less than two versions for %s", this.gk)
+	}
+	for _, f := range this.Run() {
+		t.Errorf("conversion round trip for %s: %s", this.gk, f)
+		if f.Diff != "" {
+			t.Logf("diff (original/round trip):\n%s", f.Diff)
+		}
+	}
+}
+
+// Run converts fuzzed objects of every version to every other version and
+// back and returns the failed round trips.
+func (this *Tester) Run() []Failure {
+	var failures []Failure
+
+	codecs := serializer.NewCodecFactory(this.scheme)
+	funcs := append([]fuzzer.FuzzerFuncs{metafuzzer.Funcs, rawExtensionFuncs}, this.funcs...)
+	filler := fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(funcs...), rand.NewSource(this.seed), codecs)
+	handler := conversion.NewHTTPHandler(logger.New(), "roundtrip", this.handler)
+
+	versions := this.Versions()
+	for _, a := range versions {
+		objects, err := this.fuzz(filler, a)
+		if err != nil {
+			return append(failures, Failure{Version: a, Via: a, Err: err})
+		}
+		// the objects converted to their own version are used as reference
+		// to exclude effects of the decoding, like defaulting
+		expected, err := review(handler, a, objects)
+		if err != nil {
+			failures = append(failures, Failure{Version: a, Via: a, Err: fmt.Errorf("conversion to own version: %s", err)})
+			continue
+		}
+		for _, b := range versions {
+			if a == b {
+				continue
+			}
+			converted, err := review(handler, b, objects)
+			if err != nil {
+				failures = append(failures, Failure{Version: a, Via: b, Err: fmt.Errorf("conversion to %s: %s", b.Version, err)})
+				continue
+			}
+			back, err := review(handler, a, converted)
+			if err != nil {
+				failures = append(failures, Failure{Version: a, Via: b, Err: fmt.Errorf("conversion back to %s: %s", a.Version, err)})
+				continue
+			}
+			for i := range expected {
+				if f := this.compare(a, expected[i], back[i]); f != nil {
+					f.Via = b
+					failures = append(failures, *f)
+				}
+			}
+		}
+	}
+	return failures
+}
+
+func (this *Tester) fuzz(filler *randfill.Filler, gvk schema.GroupVersionKind) ([]runtime.RawExtension, error) {
+	var objects []runtime.RawExtension
+	for i := 0; i < this.iterations; i++ {
+		obj, err := this.scheme.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		filler.Fill(obj)
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, runtime.RawExtension{Raw: data})
+	}
+	return objects, nil
+}
+
+// review sends a conversion review for the given objects
+// to the HTTP handler.
+func review(handler http.Handler, gvk schema.GroupVersionKind, objects []runtime.RawExtension) ([]runtime.RawExtension, error) {
+	req := &apiextv1.ConversionReview{
+		Request: &apiextv1.ConversionRequest{
+			UID:               types.UID("roundtrip"),
+			DesiredAPIVersion: gvk.GroupVersion().String(),
+			Objects:           objects,
+		},
+	}
+	req.SetGroupVersionKind(apiextv1.SchemeGroupVersion.WithKind("ConversionReview"))
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	r := httptest.NewRequest(http.MethodPost, "/convert", bytes.NewReader(body))
+	r.Header.Set("Content-Type", runtime.ContentTypeJSON)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		return nil, fmt.Errorf("webhook responded with status %d", w.Code)
+	}
+	resp := &apiextv1.ConversionReview{}
+	if err := json.Unmarshal(w.Body.Bytes(), resp); err != nil {
+		return nil, err
+	}
+	if resp.Response == nil {
+		return nil, fmt.Errorf("no response")
+	}
+	if resp.Response.Result.Status != conversion.SUCCESS {
+		return nil, fmt.Errorf("%s", resp.Response.Result.Message)
+	}
+	if len(resp.Response.ConvertedObjects) != len(objects) {
+		return nil, fmt.Errorf("got %d objects for %d requested", len(resp.Response.ConvertedObjects), len(objects))
+	}
+	return resp.Response.ConvertedObjects, nil
+}
+
+func (this *Tester) compare(gvk schema.GroupVersionKind, expected, actual runtime.RawExtension) *Failure {
+	e, err := this.decode(gvk, expected)
+	if err != nil {
+		return &Failure{Version: gvk, Err: err}
+	}
+	a, err := this.decode(gvk, actual)
+	if err != nil {
+		return &Failure{Version: gvk, Err: err}
+	}
+	if apiequality.Semantic.DeepEqual(e, a) {
+		return nil
+	}
+	var emap, amap interface{}
+	_ = json.Unmarshal(expected.Raw, &emap)
+	_ = json.Unmarshal(actual.Raw, &amap)
+	fields := lossyFields("", emap, amap, nil)
+	if len(fields) == 0 {
+		fields = []string{"<unknown>"}
+	}
+	return &Failure{Version: gvk, Fields: fields, Diff: diff.Diff(e, a)}
+}
+
+func (this *Tester) decode(gvk schema.GroupVersionKind, raw runtime.RawExtension) (runtime.Object, error) {
+	obj, err := this.scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw.Raw, obj); err != nil {
+		return nil, fmt.Errorf("cannot decode %s: %s", gvk.Version, err)
+	}
+	return obj, nil
+}
+
+// lossyFields determines the paths of the differing fields
+// of two JSON documents.
+func lossyFields(path string, expected, actual interface{}, fields []string) []string {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		if a, ok := actual.(map[string]interface{}); ok {
+			keys := map[string]struct{}{}
+			for k := range e {
+				keys[k] = struct{}{}
+			}
+			for k := range a {
+				keys[k] = struct{}{}
+			}
+			var sorted []string
+			for k := range keys {
+				sorted = append(sorted, k)
+			}
+			sort.Strings(sorted)
+			for _, k := range sorted {
+				fields = lossyFields(join(path, k), e[k], a[k], fields)
+			}
+			return fields
+		}
+	case []interface{}:
+		if a, ok := actual.([]interface{}); ok && len(a) == len(e) {
+			for i := range e {
+				fields = lossyFields(fmt.Sprintf("%s[%d]", path, i), e[i], a[i], fields)
+			}
+			return fields
+		}
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		if path == "" {
+			path = "."
+		}
+		fields = append(fields, path)
+	}
+	return fields
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// rawExtensionFuncs fuzzes raw extensions with JSON objects, which
+// survive the re-encoding by the conversion.
+func rawExtensionFuncs(codecs serializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(r *runtime.RawExtension, c randfill.Continue) {
+			data, err := json.Marshal(map[string]string{c.String(10): c.String(10)})
+			if err != nil {
+				panic(fmt.Sprintf("failed to encode raw extension: %s", err))
+			}
+			r.Raw = data
+		},
+	}
+}
@@ -0,0 +1,105 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package roundtrip_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/randfill"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/examples/apis/example"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/examples/apis/example/install"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/examples/apis/example/v1alpha1"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/examples/apis/example/v1beta1"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/webhook/conversion/roundtrip"
+)
+
+const alphanumeric = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+func word(c randfill.Continue) string {
+	b := make([]byte, 1+c.Intn(10))
+	for i := range b {
+		b[i] = alphanumeric[c.Intn(len(alphanumeric))]
+	}
+	return string(b)
+}
+
+// urlFuncs restricts the URL fields of the example versions to the values
+// accepted by the API: URLs with scheme, host, optional port and path.
+func urlFuncs(_ serializer.CodecFactory) []interface{} {
+	schemes := []string{"http", "https"}
+	port := func(c randfill.Continue) int {
+		if c.Bool() {
+			return 0
+		}
+		return 1 + c.Intn(65535)
+	}
+	path := func(c randfill.Continue) string {
+		switch c.Intn(3) {
+		case 0:
+			return ""
+		case 1:
+			return word(c)
+		}
+		return word(c) + "/" + word(c)
+	}
+	return []interface{}{
+		func(s *v1alpha1.ExampleSpec, c randfill.Continue) {
+			c.FillNoCustom(s)
+			s.URLScheme = schemes[c.Intn(len(schemes))]
+			s.Hostname = word(c) + ".example.com"
+			s.Port = port(c)
+			s.Path = path(c)
+		},
+		func(s *v1beta1.ExampleSpec, c randfill.Continue) {
+			c.FillNoCustom(s)
+			host := word(c) + ".example.com"
+			if p := port(c); p > 0 {
+				host = fmt.Sprintf("%s:%d", host, p)
+			}
+			s.URL = fmt.Sprintf("%s://%s/%s", schemes[c.Intn(len(schemes))], host, path(c))
+		},
+	}
+}
+
+var _ = Describe("round trip", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		install.Install(scheme)
+	})
+
+	It("finds the versions of the example kind", func() {
+		tester := roundtrip.New(scheme, example.Kind("Example"))
+		Expect(tester.Versions()).To(Equal([]schema.GroupVersionKind{
+			v1alpha1.SchemeGroupVersion.WithKind("Example"),
+			v1beta1.SchemeGroupVersion.WithKind("Example"),
+		}))
+	})
+
+	It("converts valid examples without loss", func() {
+		failures := roundtrip.New(scheme, example.Kind("Example")).Iterations(50).Funcs(urlFuncs).Run()
+		Expect(failures).To(BeEmpty())
+	})
+
+	It("reports lossy conversions of invalid URLs", func() {
+		failures := roundtrip.New(scheme, example.Kind("Example")).Run()
+		Expect(failures).NotTo(BeEmpty())
+		var messages []string
+		for _, f := range failures {
+			messages = append(messages, f.String())
+		}
+		Expect(messages).To(ContainElement(ContainSubstring("v1beta1 via v1alpha1")))
+	})
+})
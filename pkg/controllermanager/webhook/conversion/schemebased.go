@@ -47,7 +47,13 @@ type schemehandler struct {
 }
 
 func schemetype(wh webhook.Interface) (Interface, error) {
-	return &schemehandler{wh.GetScheme(), resources.NewDecoder(wh.GetScheme())}, nil
+	return NewSchemeHandler(wh.GetScheme()), nil
+}
+
+// NewSchemeHandler creates a conversion handler using the conversions
+// of the given scheme as used by SchemeBasedConversion.
+func NewSchemeHandler(scheme *runtime.Scheme) Interface {
+	return &schemehandler{scheme, resources.NewDecoder(scheme)}
 }
 
 var _ ConversionHandlerType = schemetype
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/webhook"
+	"github.com/gardener/controller-manager-library/pkg/resources"
+)
+
+// selfTest converts a sample object of every version of the webhook
+// resources known by the webhook scheme to every other version and back,
+// and checks that the result equals the original object.
+func selfTest(wh webhook.Interface, handler *HTTPHandler) error {
+	scheme := wh.GetScheme()
+	if scheme == nil {
+		return nil
+	}
+	logctx := handler.NewContext("selftest", "conversion")
+	for _, r := range wh.GetDefinition().Resources() {
+		gk := r.GroupKind()
+		versions := schemeVersions(scheme, gk)
+		if len(versions) < 2 {
+			continue
+		}
+		for _, a := range versions {
+			orig, err := sampleObject(scheme, a)
+			if err != nil {
+				return err
+			}
+			for _, b := range versions {
+				if a == b {
+					continue
+				}
+				if err := roundTrip(scheme, handler, orig, a, b); err != nil {
+					return fmt.Errorf("conversion self-test for %s failed: %s", gk, err)
+				}
+			}
+		}
+		logctx.Infof("conversion self-test for %s versions %v succeeded", gk, versions)
+	}
+	return nil
+}
+
+// schemeVersions returns the external versions of a group kind known by a scheme.
+func schemeVersions(scheme *runtime.Scheme, gk schema.GroupKind) []schema.GroupVersionKind {
+	var versions []schema.GroupVersionKind
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.GroupKind() == gk && gvk.Version != runtime.APIVersionInternal {
+			versions = append(versions, gvk)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	return versions
+}
+
+func sampleObject(scheme *runtime.Scheme, gvk schema.GroupVersionKind) (runtime.Object, error) {
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	scheme.Default(obj)
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	if o, ok := obj.(resources.ObjectData); ok {
+		o.SetName("conversion-selftest")
+		o.SetNamespace("default")
+	}
+	return obj, nil
+}
+
+func roundTrip(scheme *runtime.Scheme, handler *HTTPHandler, orig runtime.Object, a, b schema.GroupVersionKind) error {
+	raw, err := json.Marshal(orig)
+	if err != nil {
+		return err
+	}
+	logctx := handler.NewContext("selftest", fmt.Sprintf("%s->%s", a.Version, b.Version))
+	converted, err := handler.convert(logctx, b.GroupVersion().String(), runtime.RawExtension{Raw: raw})
+	if err != nil {
+		return fmt.Errorf("%s to %s: %s", a.Version, b.Version, err)
+	}
+	back, err := handler.convert(logctx, a.GroupVersion().String(), converted)
+	if err != nil {
+		return fmt.Errorf("%s back to %s: %s", b.Version, a.Version, err)
+	}
+	result, err := scheme.New(a)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(back.Raw, result); err != nil {
+		return fmt.Errorf("cannot decode %s: %s", a.Version, err)
+	}
+	result.GetObjectKind().SetGroupVersionKind(a)
+	if !apiequality.Semantic.DeepEqual(orig, result) {
+		return fmt.Errorf("round trip %s -> %s -> %s is lossy", a.Version, b.Version, a.Version)
+	}
+	return nil
+}
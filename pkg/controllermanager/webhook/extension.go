@@ -19,6 +19,7 @@ import (
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
 	parentcfg "github.com/gardener/controller-manager-library/pkg/controllermanager/config"
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/extension"
+	"github.com/gardener/controller-manager-library/pkg/controllermanager/server/ready"
 	areacfg "github.com/gardener/controller-manager-library/pkg/controllermanager/webhook/config"
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"github.com/gardener/controller-manager-library/pkg/resources"
@@ -297,9 +298,15 @@ func (this *Extension) Start(ctx context.Context) error {
 		return nil
 	}
 
+	clientAuth, err := this.config.CertConfig.ClientAuthConfig()
+	if err != nil {
+		return err
+	}
+	this.server.SetClientAuth(clientAuth)
 	this.server.Start(this.certificate, "", this.config.Port)
 
 	if !this.config.OmitRegistrations {
+		ready.RegisterCheck("webhooks", &this.maintained)
 		registrations := WebhookRegistrationGroups{}
 
 		for _, w := range this.hooks {
@@ -348,7 +355,7 @@ func (this *Extension) RegisterHandler(wh Interface) error {
 
 func (this *Extension) CreateWebhookClientConfig(msg string, def Definition, target resources.Cluster) (apiextensions.WebhookClientConfigSource, error) {
 	var client apiextensions.WebhookClientConfigSource
-	cabundle := this.certificate.GetCertificateInfo().CACert()
+	cabundle := this.certificate.GetCertificateInfo().CABundle()
 	if len(cabundle) == 0 {
 		return nil, fmt.Errorf("no cert authority given")
 	}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/gardener/controller-manager-library/pkg/controllermanager/cluster"
 	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/server/healthz"
 	"github.com/gardener/controller-manager-library/pkg/utils"
 	"github.com/gardener/controller-manager-library/pkg/wait"
 )
@@ -30,10 +31,19 @@ type MaintainedRegistration struct {
 	handler      RegistrationHandler
 	cluster      cluster.Interface
 	declarations WebhookDeclarations
+	err          error
 }
 
 func (this *MaintainedRegistration) register(ext *Extension) error {
-	return this.handler.Register(ext.regctxs[this.declarations[0].Kind()], ext.labels, this.cluster, this.name, this.declarations...)
+	err := this.handler.Register(ext.regctxs[this.declarations[0].Kind()], ext.labels, this.cluster, this.name, this.declarations...)
+	ext.maintained.lock.Lock()
+	this.err = err
+	ext.maintained.lock.Unlock()
+	return err
+}
+
+func (this *MaintainedRegistration) String() string {
+	return fmt.Sprintf("%s/%s/%s", this.cluster.GetName(), this.declarations[0].Kind(), this.name)
 }
 
 type MaintainedRegistrations struct {
@@ -85,6 +95,29 @@ func (this *MaintainedRegistrations) removeRegistration(handler RegistrationHand
 	}
 }
 
+// Check reports the state of the last registration
+// (update) of all maintained registrations.
+func (this *MaintainedRegistrations) Check() healthz.Status {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	status := healthz.NewStatus("webhooks", "")
+	failed := 0
+	for _, r := range this.registrations {
+		if r.err != nil {
+			failed++
+			status.Components = append(status.Components, healthz.NewStatus(r.String(), r.err.Error()))
+		} else {
+			status.Components = append(status.Components, healthz.NewStatus(r.String(), ""))
+		}
+	}
+	if failed > 0 {
+		status.Healthy = false
+		status.Reason = fmt.Sprintf("%d of %d webhook registrations failed", failed, len(this.registrations))
+	}
+	return status
+}
+
 func (this *MaintainedRegistrations) TriggerRegistrationUpdate(ext *Extension) {
 	this.lock.Lock()
 	defer this.lock.Unlock()
@@ -0,0 +1,153 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/gardener/controller-manager-library/pkg/informerfactories"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// cacheSyncLogInterval is the interval for logging the progress
+// of a pending cache sync.
+const cacheSyncLogInterval = 30 * time.Second
+
+// CacheSyncError is returned if the cache of an informer could not
+// be synced within the cache sync timeout of a cluster.
+type CacheSyncError struct {
+	GroupVersionKind schema.GroupVersionKind
+	// Namespace is the namespace watched by the informer, or empty for all namespaces.
+	Namespace string
+	Cluster   string
+	Timeout   time.Duration
+	// Err is the last error reported for the list and watch requests, if any,
+	// for example a missing permission or resource.
+	Err error
+}
+
+func (this *CacheSyncError) Error() string {
+	msg := fmt.Sprintf("cache for %s", this.GroupVersionKind)
+	if this.Namespace != "" {
+		msg += fmt.Sprintf(" in namespace %s", this.Namespace)
+	}
+	msg += fmt.Sprintf(" of cluster %s not synced within %s", this.Cluster, this.Timeout)
+	if this.Err != nil {
+		return msg + ": " + this.Err.Error()
+	}
+	return msg + " (no API error reported)"
+}
+
+func (this *CacheSyncError) Unwrap() error {
+	return this.Err
+}
+
+// watchErrorHandler records the last error of the reflector, which is reported
+// if the cache cannot be synced. If the watched resource has vanished for a synced
+// cache (eg. CRD has been deleted or kube-apiserver has been updated), the informer
+// is marked as lost. Instead of panicking, the loss is reported by the informer status,
+// which fails the liveness check of the cluster.
+func (f *genericInformer) watchErrorHandler(r *cache.Reflector, err error) {
+	lost := f.HasSynced() && strings.Contains(err.Error(), "failed to list") && strings.Contains(err.Error(), "the server could not find the requested resource")
+
+	f.lock.Lock()
+	f.watchErr = err
+	first := lost && !f.lost
+	if lost {
+		f.lost = true
+	}
+	f.lock.Unlock()
+
+	if first {
+		logger.Errorf("watched resource %s has vanished: %s", f.resource.GroupVersionKind(), err)
+	}
+	cache.DefaultWatchErrorHandler(context.Background(), r, err)
+}
+
+// syncState returns whether the cache sync has timed out,
+// whether the watched resource has vanished and the last watch error.
+func (f *genericInformer) syncState() (bool, bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.timedOut, f.lost, f.watchErr
+}
+
+func (f *genericInformer) setTimedOut() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.timedOut = true
+}
+
+// startAndWaitForCacheSync starts the informers of a factory and waits for the
+// cache of the given informer to be synced. If a cache sync timeout is configured
+// for the resource context, a CacheSyncError is returned after the timeout.
+func startAndWaitForCacheSync(rctx ResourceContext, informers informerfactories.StartInterface, informer GenericInformer, gvk schema.GroupVersionKind, namespace string) error {
+	informers.Start(rctx.Done())
+	if informer.HasSynced() {
+		return nil
+	}
+
+	generic, _ := informer.(*genericInformer)
+	lastError := func() error {
+		if generic == nil {
+			return nil
+		}
+		_, _, err := generic.syncState()
+		return err
+	}
+	desc := gvk.String()
+	if namespace != "" {
+		desc += fmt.Sprintf(" in namespace %s", namespace)
+	}
+
+	var expired <-chan time.Time
+	timeout := rctx.GetCacheSyncTimeout()
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	poll := time.NewTicker(100 * time.Millisecond)
+	defer poll.Stop()
+
+	start := time.Now()
+	logged := start
+	for !informer.HasSynced() {
+		select {
+		case <-rctx.Done():
+			return fmt.Errorf("failed to wait for caches to sync")
+		case <-expired:
+			if generic != nil {
+				generic.setTimedOut()
+			}
+			return &CacheSyncError{
+				GroupVersionKind: gvk,
+				Namespace:        namespace,
+				Cluster:          rctx.GetName(),
+				Timeout:          timeout,
+				Err:              lastError(),
+			}
+		case now := <-poll.C:
+			if now.Sub(logged) < cacheSyncLogInterval {
+				continue
+			}
+			logged = now
+			if err := lastError(); err != nil {
+				logger.Warnf("cache for %s (cluster %s) still not synced after %s: %s", desc, rctx.GetName(), now.Sub(start).Round(time.Second), err)
+			} else {
+				logger.Infof("cache for %s (cluster %s) still not synced after %s", desc, rctx.GetName(), now.Sub(start).Round(time.Second))
+			}
+		}
+	}
+	return nil
+}
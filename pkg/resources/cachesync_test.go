@@ -0,0 +1,168 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type testResourceContext struct {
+	ResourceContext
+	ctx     context.Context
+	timeout time.Duration
+}
+
+func (this *testResourceContext) Done() <-chan struct{} {
+	return this.ctx.Done()
+}
+
+func (this *testResourceContext) GetName() string {
+	return "default"
+}
+
+func (this *testResourceContext) GetCacheSyncTimeout() time.Duration {
+	return this.timeout
+}
+
+type testStarter func(stop <-chan struct{})
+
+func (this testStarter) Start(stop <-chan struct{}) {
+	this(stop)
+}
+
+type syncedInformer struct {
+	cache.SharedIndexInformer
+}
+
+func (this *syncedInformer) HasSynced() bool {
+	return true
+}
+
+var secrets = schema.GroupVersionKind{Version: "v1", Kind: "Secret"}
+
+func newTestInformer(listErr error) (*genericInformer, testStarter) {
+	lw := &cache.ListWatch{
+		ListWithContextFunc: func(_ context.Context, _ metav1.ListOptions) (runtime.Object, error) {
+			if listErr != nil {
+				return nil, listErr
+			}
+			return &corev1.SecretList{}, nil
+		},
+		WatchFuncWithContext: func(_ context.Context, _ metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}
+	informer := cache.NewSharedIndexInformer(lw, &corev1.Secret{}, 0, cache.Indexers{})
+	generic := &genericInformer{SharedIndexInformer: informer, resource: &Info{groupVersion: &schema.GroupVersion{Version: "v1"}, kind: "Secret"}}
+	Expect(informer.SetWatchErrorHandler(generic.watchErrorHandler)).To(Succeed())
+	return generic, func(stop <-chan struct{}) { go informer.Run(stop) }
+}
+
+var _ = Describe("cache sync", func() {
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+		rctx   *testResourceContext
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		rctx = &testResourceContext{ctx: ctx}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("waits for the cache to be synced", func() {
+		informer, start := newTestInformer(nil)
+		Expect(startAndWaitForCacheSync(rctx, start, informer, secrets, "")).To(Succeed())
+		Expect(informer.HasSynced()).To(BeTrue())
+	})
+
+	It("times out with the group version kind and the last API error", func() {
+		forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", errors.New("missing permission"))
+		informer, start := newTestInformer(forbidden)
+		rctx.timeout = 500 * time.Millisecond
+
+		began := time.Now()
+		err := startAndWaitForCacheSync(rctx, start, informer, secrets, "kube-system")
+		Expect(time.Since(began)).To(BeNumerically(">=", rctx.timeout))
+
+		var syncErr *CacheSyncError
+		Expect(errors.As(err, &syncErr)).To(BeTrue())
+		Expect(syncErr.GroupVersionKind).To(Equal(secrets))
+		Expect(syncErr.Namespace).To(Equal("kube-system"))
+		Expect(syncErr.Cluster).To(Equal("default"))
+		Expect(syncErr.Timeout).To(Equal(rctx.timeout))
+		Expect(apierrors.IsForbidden(syncErr.Err)).To(BeTrue())
+		Expect(err.Error()).To(HavePrefix("cache for /v1, Kind=Secret in namespace kube-system of cluster default not synced within 500ms: "))
+		Expect(err.Error()).To(ContainSubstring("missing permission"))
+
+		timedOut, lost, last := informer.syncState()
+		Expect(timedOut).To(BeTrue())
+		Expect(lost).To(BeFalse())
+		Expect(apierrors.IsForbidden(last)).To(BeTrue())
+	})
+
+	It("stops waiting if the context is done", func() {
+		informer, start := newTestInformer(errors.New("connection refused"))
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			cancel()
+		}()
+		err := startAndWaitForCacheSync(rctx, start, informer, secrets, "")
+		Expect(err).To(MatchError("failed to wait for caches to sync"))
+		timedOut, _, _ := informer.syncState()
+		Expect(timedOut).To(BeFalse())
+	})
+
+	It("reports a cache sync error without API error", func() {
+		err := &CacheSyncError{GroupVersionKind: secrets, Cluster: "target", Timeout: time.Minute}
+		Expect(err.Error()).To(Equal("cache for /v1, Kind=Secret of cluster target not synced within 1m0s (no API error reported)"))
+		Expect(errors.Unwrap(err)).To(BeNil())
+	})
+
+	Context("watch errors", func() {
+		notFound := fmt.Errorf("failed to list *v1.Secret: the server could not find the requested resource")
+		var reflector *cache.Reflector
+
+		BeforeEach(func() {
+			reflector = cache.NewReflector(&cache.ListWatch{}, &corev1.Secret{}, cache.NewStore(cache.MetaNamespaceKeyFunc), 0)
+		})
+
+		It("marks a synced informer as lost instead of panicking", func() {
+			informer := &genericInformer{SharedIndexInformer: &syncedInformer{}, resource: &Info{groupVersion: &schema.GroupVersion{Version: "v1"}, kind: "Secret"}}
+			Expect(func() { informer.watchErrorHandler(reflector, notFound) }).NotTo(Panic())
+			_, lost, last := informer.syncState()
+			Expect(lost).To(BeTrue())
+			Expect(last).To(Equal(notFound))
+		})
+
+		It("records the error of an informer not synced", func() {
+			informer, _ := newTestInformer(nil)
+			informer.watchErrorHandler(reflector, notFound)
+			_, lost, last := informer.syncState()
+			Expect(lost).To(BeFalse())
+			Expect(last).To(Equal(notFound))
+		})
+	})
+})
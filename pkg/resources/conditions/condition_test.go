@@ -16,6 +16,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type My struct {
@@ -237,4 +238,25 @@ var _ = Describe("Conditions", func() {
 		})
 	})
 
+	Context("MetaV1", func() {
+		type Std struct {
+			Status struct {
+				Conditions []metav1.Condition
+			}
+		}
+		var std = conditions.NewConditionType("Ready", conditions.MetaV1ConditionLayout)
+
+		It("sets standard conditions", func() {
+			my := &Std{}
+			now := time.Now()
+			Expect(std.SetStatus(my, "True")).NotTo(HaveOccurred())
+			Expect(std.SetReason(my, "Succeeded")).NotTo(HaveOccurred())
+
+			Expect(my.Status.Conditions).To(HaveLen(1))
+			Expect(my.Status.Conditions[0].Type).To(Equal("Ready"))
+			Expect(string(my.Status.Conditions[0].Status)).To(Equal("True"))
+			Expect(my.Status.Conditions[0].Reason).To(Equal("Succeeded"))
+			Expect(my.Status.Conditions[0].LastTransitionTime.Time).NotTo(BeTemporally("<", now))
+		})
+	})
 })
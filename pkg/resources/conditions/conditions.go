@@ -398,6 +398,10 @@ type ConditionType struct {
 
 var defaultLayout = NewConditionLayout()
 
+// MetaV1ConditionLayout is the condition layout for objects
+// using the standard metav1.Condition type for their conditions.
+var MetaV1ConditionLayout = NewConditionLayout(TransitionTimeField("LastTransitionTime"), LastUpdateTimeField(""))
+
 func NewConditionType(name string, t *ConditionLayout) *ConditionType {
 	if t == nil {
 		t = defaultLayout
@@ -31,6 +31,11 @@ type ResourceContext interface {
 
 	SharedInformerFactory() SharedInformerFactory
 
+	// GetCacheSyncTimeout returns the maximum duration to wait for the initial
+	// sync of an informer cache. Zero means no timeout.
+	GetCacheSyncTimeout() time.Duration
+	SetCacheSyncTimeout(timeout time.Duration)
+
 	GetPreferred(gk schema.GroupKind) (*Info, error)
 	Get(gvk schema.GroupVersionKind) (*Info, error)
 }
@@ -43,6 +48,7 @@ type resourceContext struct {
 	*abstract.AbstractResourceContext
 
 	defaultResync         time.Duration
+	cacheSyncTimeout      time.Duration
 	sharedInformerFactory *sharedInformerFactory
 }
 
@@ -106,6 +112,14 @@ func (c *resourceContext) GetGVK(obj runtime.Object) (schema.GroupVersionKind, e
 	return empty, errors.New(errors.ERR_NON_UNIQUE_MAPPING, "non unique mapping for %T", obj)
 }
 
+func (c *resourceContext) GetCacheSyncTimeout() time.Duration {
+	return c.cacheSyncTimeout
+}
+
+func (c *resourceContext) SetCacheSyncTimeout(timeout time.Duration) {
+	c.cacheSyncTimeout = timeout
+}
+
 // NewSharedInformerFactory constructs a new instance of sharedInformerFactory for all namespaces.
 func (c *resourceContext) SharedInformerFactory() SharedInformerFactory {
 	c.AbstractResourceContext.Lock()
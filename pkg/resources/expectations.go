@@ -0,0 +1,346 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package resources
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultExpectationTimeout is the default time after which
+// unobserved expectations are treated as satisfied.
+const DefaultExpectationTimeout = 5 * time.Minute
+
+type expectation struct {
+	creations ClusterObjectKeySet
+	deletions ClusterObjectKeySet
+	// generated are the numbers of pending creations of slaves with
+	// generated names per cluster, group kind and namespace.
+	generated map[ClusterObjectKey]int
+	// known are the slaves of the owner existing when the generated
+	// creations have been expected. Their observation is not counted.
+	known     ClusterObjectKeySet
+	timestamp time.Time
+}
+
+func (this *expectation) pending() bool {
+	return len(this.creations) > 0 || len(this.deletions) > 0 || len(this.generated) > 0
+}
+
+func (this *expectation) lowerGenerated(class ClusterObjectKey, n int) {
+	if c := this.generated[class] - n; c > 0 {
+		this.generated[class] = c
+	} else {
+		delete(this.generated, class)
+	}
+}
+
+// generatedClass is the key used to count the creations of
+// slaves with generated names.
+func generatedClass(slave ClusterObjectKey) ClusterObjectKey {
+	return NewClusterKey(slave.Cluster(), slave.GroupKind(), slave.Namespace(), "")
+}
+
+// Expectations keeps track of the slave objects created or deleted on
+// behalf of an owner, which are not yet observed by the informer caches.
+// As long as there are pending expectations for an owner its reconcilation
+// should be skipped or delayed to avoid decisions based on stale cache
+// content (for example creating a slave a second time).
+// Expectations not observed within the timeout are treated as satisfied.
+//
+// The creation of slaves with generated names is expected by counting
+// (ExpectGeneratedCreations) before the create request is sent, because the
+// creation may be observed before the request returns. The first
+// observation of a new slave owned by the owner lowers the count. Slaves
+// known when the creations are expected (for example updated siblings)
+// are not counted.
+//
+// All methods may be called on a nil instance, which never has pending
+// expectations.
+type Expectations struct {
+	lock    sync.Mutex
+	timeout time.Duration
+	owners  map[ClusterObjectKey]*expectation
+	slaves  map[ClusterObjectKey]ClusterObjectKeySet
+	// generated are the slaves with generated names per owner,
+	// whose creation has been observed before the create
+	// request returned.
+	generated map[ClusterObjectKey]ClusterObjectKeySet
+}
+
+// NewExpectations creates an expectation tracker using the given timeout.
+// If no timeout is given, DefaultExpectationTimeout is used.
+func NewExpectations(timeout time.Duration) *Expectations {
+	if timeout <= 0 {
+		timeout = DefaultExpectationTimeout
+	}
+	return &Expectations{
+		timeout:   timeout,
+		owners:    map[ClusterObjectKey]*expectation{},
+		slaves:    map[ClusterObjectKey]ClusterObjectKeySet{},
+		generated: map[ClusterObjectKey]ClusterObjectKeySet{},
+	}
+}
+
+func (this *Expectations) Timeout() time.Duration {
+	if this == nil {
+		return 0
+	}
+	return this.timeout
+}
+
+func (this *Expectations) assure(owner ClusterObjectKey) *expectation {
+	e := this.owners[owner]
+	if e == nil {
+		e = &expectation{creations: ClusterObjectKeySet{}, deletions: ClusterObjectKeySet{}, generated: map[ClusterObjectKey]int{}, known: ClusterObjectKeySet{}}
+		this.owners[owner] = e
+	}
+	e.timestamp = time.Now()
+	return e
+}
+
+func (this *Expectations) link(owner ClusterObjectKey, slave ClusterObjectKey) {
+	set := this.slaves[slave]
+	if set == nil {
+		set = ClusterObjectKeySet{}
+		this.slaves[slave] = set
+	}
+	set.Add(owner)
+}
+
+func (this *Expectations) unlink(owner ClusterObjectKey, slave ClusterObjectKey) {
+	if set := this.slaves[slave]; set != nil {
+		set.Remove(owner)
+		if len(set) == 0 {
+			delete(this.slaves, slave)
+		}
+	}
+}
+
+// ExpectCreations records the expected creation of the given slaves for an owner.
+func (this *Expectations) ExpectCreations(owner ClusterObjectKey, slaves ...ClusterObjectKey) {
+	if this == nil || len(slaves) == 0 {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	e := this.assure(owner)
+	for _, s := range slaves {
+		e.deletions.Remove(s)
+		e.creations.Add(s)
+		this.link(owner, s)
+	}
+}
+
+// ExpectGeneratedCreations records the expected creation of n slaves with
+// generated names for an owner. The template is the key of the slaves
+// without name, known are the slaves of the owner already existing, whose
+// observation (for example an update) must not satisfy the expectation.
+// It must be called before the create requests are sent.
+// After a request returns, either GeneratedCreationSucceeded or
+// LowerGeneratedCreations must be called.
+func (this *Expectations) ExpectGeneratedCreations(owner ClusterObjectKey, template ClusterObjectKey, n int, known ...ClusterObjectKey) {
+	if this == nil || n <= 0 {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	e := this.assure(owner)
+	e.generated[generatedClass(template)] += n
+	e.known.Add(known...)
+}
+
+// LowerGeneratedCreations lowers the number of expected creations of slaves
+// with generated names, for example if the create request failed.
+func (this *Expectations) LowerGeneratedCreations(owner ClusterObjectKey, template ClusterObjectKey, n int) {
+	if this == nil || n <= 0 {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if e := this.owners[owner]; e != nil {
+		e.lowerGenerated(generatedClass(template), n)
+		if !e.pending() {
+			delete(this.owners, owner)
+		}
+	}
+}
+
+// GeneratedCreationSucceeded must be called with the key of a slave with
+// a generated name after its create request succeeded. If the creation
+// has not yet been observed, the expected creation is kept for the
+// actual key of the slave.
+func (this *Expectations) GeneratedCreationSucceeded(owner ClusterObjectKey, slave ClusterObjectKey) {
+	if this == nil {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if set := this.generated[owner]; set.Contains(slave) {
+		set.Remove(slave)
+		if len(set) == 0 {
+			delete(this.generated, owner)
+		}
+		return
+	}
+	e := this.assure(owner)
+	e.lowerGenerated(generatedClass(slave), 1)
+	e.deletions.Remove(slave)
+	e.creations.Add(slave)
+	this.link(owner, slave)
+}
+
+// ExpectDeletions records the expected deletion of the given slaves for an owner.
+func (this *Expectations) ExpectDeletions(owner ClusterObjectKey, slaves ...ClusterObjectKey) {
+	if this == nil || len(slaves) == 0 {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	e := this.assure(owner)
+	for _, s := range slaves {
+		e.creations.Remove(s)
+		e.deletions.Add(s)
+		this.link(owner, s)
+	}
+}
+
+// CancelExpectation removes the expectation for a slave of an owner,
+// for example if the create or delete request failed.
+func (this *Expectations) CancelExpectation(owner ClusterObjectKey, slave ClusterObjectKey) {
+	if this == nil {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if e := this.owners[owner]; e != nil {
+		e.creations.Remove(slave)
+		e.deletions.Remove(slave)
+		if !e.pending() {
+			delete(this.owners, owner)
+		}
+	}
+	this.unlink(owner, slave)
+}
+
+// CreationObserved marks the creation of a slave as observed for all owners.
+// The owners of the slave are used to match expected creations of slaves
+// with generated names. Slaves known to an owner when the creations have
+// been expected are ignored for the matching.
+func (this *Expectations) CreationObserved(slave ClusterObjectKey, owners ...ClusterObjectKey) {
+	this.observed(slave, func(e *expectation) ClusterObjectKeySet { return e.creations })
+	if this == nil || len(owners) == 0 {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	class := generatedClass(slave)
+	for _, owner := range owners {
+		e := this.owners[owner]
+		if e == nil || e.generated[class] == 0 || e.known.Contains(slave) {
+			continue
+		}
+		set := this.generated[owner]
+		if set == nil {
+			set = ClusterObjectKeySet{}
+			this.generated[owner] = set
+		}
+		if set.Contains(slave) {
+			continue
+		}
+		set.Add(slave)
+		e.lowerGenerated(class, 1)
+		if !e.pending() {
+			delete(this.owners, owner)
+		}
+	}
+}
+
+// DeletionObserved marks the deletion of a slave as observed for all owners.
+func (this *Expectations) DeletionObserved(slave ClusterObjectKey) {
+	this.observed(slave, func(e *expectation) ClusterObjectKeySet { return e.deletions })
+}
+
+func (this *Expectations) observed(slave ClusterObjectKey, set func(e *expectation) ClusterObjectKeySet) {
+	if this == nil {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	for owner := range this.slaves[slave] {
+		e := this.owners[owner]
+		if e == nil {
+			this.unlink(owner, slave)
+			continue
+		}
+		s := set(e)
+		if s.Contains(slave) {
+			s.Remove(slave)
+			this.unlink(owner, slave)
+			if !e.pending() {
+				delete(this.owners, owner)
+			}
+		}
+	}
+}
+
+// Satisfied checks whether all expectations of an owner are observed or
+// expired. If not, the remaining time until the expiration is returned.
+func (this *Expectations) Satisfied(owner ClusterObjectKey) (bool, time.Duration) {
+	if this == nil {
+		return true, 0
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	e := this.owners[owner]
+	if e == nil {
+		return true, 0
+	}
+	remaining := this.timeout - time.Since(e.timestamp)
+	if !e.pending() || remaining <= 0 {
+		this.deleteOwner(owner, e)
+		return true, 0
+	}
+	return false, remaining
+}
+
+// Pending returns the slaves of an owner with unobserved creations and deletions.
+func (this *Expectations) Pending(owner ClusterObjectKey) (creations, deletions ClusterObjectKeySet) {
+	if this == nil {
+		return ClusterObjectKeySet{}, ClusterObjectKeySet{}
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	e := this.owners[owner]
+	if e == nil {
+		return ClusterObjectKeySet{}, ClusterObjectKeySet{}
+	}
+	return e.creations.Copy(), e.deletions.Copy()
+}
+
+// DeleteOwner removes all expectations for an owner.
+func (this *Expectations) DeleteOwner(owner ClusterObjectKey) {
+	if this == nil {
+		return
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if e := this.owners[owner]; e != nil {
+		this.deleteOwner(owner, e)
+	}
+	delete(this.generated, owner)
+}
+
+func (this *Expectations) deleteOwner(owner ClusterObjectKey, e *expectation) {
+	for s := range e.creations {
+		this.unlink(owner, s)
+	}
+	for s := range e.deletions {
+		this.unlink(owner, s)
+	}
+	delete(this.owners, owner)
+}
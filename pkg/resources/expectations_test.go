@@ -0,0 +1,155 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package resources_test
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/gardener/controller-manager-library/pkg/resources"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Expectations", func() {
+	owners := schema.GroupKind{Group: "example.org", Kind: "Owner"}
+	secrets := schema.GroupKind{Kind: "Secret"}
+	owner := resources.NewClusterKey("c", owners, "default", "owner")
+	other := resources.NewClusterKey("c", owners, "default", "other")
+	slave := resources.NewClusterKey("c", secrets, "default", "slave")
+	template := resources.NewClusterKey("c", secrets, "default", "")
+	generated := resources.NewClusterKey("c", secrets, "default", "slave-x7f2k")
+
+	var exp *resources.Expectations
+
+	BeforeEach(func() {
+		exp = resources.NewExpectations(time.Minute)
+	})
+
+	satisfied := func(owner resources.ClusterObjectKey) bool {
+		ok, _ := exp.Satisfied(owner)
+		return ok
+	}
+
+	It("is always satisfied for a nil instance", func() {
+		var none *resources.Expectations
+		none.ExpectCreations(owner, slave)
+		none.ExpectGeneratedCreations(owner, template, 1)
+		Expect(none.Satisfied(owner)).To(BeTrue())
+	})
+
+	It("waits for expected creations", func() {
+		exp.ExpectCreations(owner, slave)
+		ok, remaining := exp.Satisfied(owner)
+		Expect(ok).To(BeFalse())
+		Expect(remaining).To(BeNumerically(">", 0))
+		Expect(satisfied(other)).To(BeTrue())
+
+		creations, _ := exp.Pending(owner)
+		Expect(creations.Contains(slave)).To(BeTrue())
+
+		exp.CreationObserved(slave)
+		Expect(satisfied(owner)).To(BeTrue())
+	})
+
+	It("waits for expected deletions", func() {
+		exp.ExpectDeletions(owner, slave)
+		exp.CreationObserved(slave)
+		Expect(satisfied(owner)).To(BeFalse())
+		exp.DeletionObserved(slave)
+		Expect(satisfied(owner)).To(BeTrue())
+	})
+
+	It("tracks a slave for multiple owners", func() {
+		exp.ExpectCreations(owner, slave)
+		exp.ExpectCreations(other, slave)
+		exp.CreationObserved(slave)
+		Expect(satisfied(owner)).To(BeTrue())
+		Expect(satisfied(other)).To(BeTrue())
+	})
+
+	It("cancels expectations", func() {
+		exp.ExpectCreations(owner, slave)
+		exp.CancelExpectation(owner, slave)
+		Expect(satisfied(owner)).To(BeTrue())
+	})
+
+	It("deletes all expectations of an owner", func() {
+		exp.ExpectCreations(owner, slave)
+		exp.ExpectGeneratedCreations(owner, template, 2)
+		exp.DeleteOwner(owner)
+		Expect(satisfied(owner)).To(BeTrue())
+	})
+
+	It("treats expired expectations as satisfied", func() {
+		exp = resources.NewExpectations(10 * time.Millisecond)
+		exp.ExpectCreations(owner, slave)
+		Expect(satisfied(owner)).To(BeFalse())
+		time.Sleep(20 * time.Millisecond)
+		Expect(satisfied(owner)).To(BeTrue())
+		// expired expectations are removed
+		creations, _ := exp.Pending(owner)
+		Expect(creations).To(BeEmpty())
+	})
+
+	Context("generated names", func() {
+		It("is satisfied by the creation observed after the request", func() {
+			exp.ExpectGeneratedCreations(owner, template, 1)
+			exp.GeneratedCreationSucceeded(owner, generated)
+			Expect(satisfied(owner)).To(BeFalse())
+			exp.CreationObserved(generated, owner)
+			Expect(satisfied(owner)).To(BeTrue())
+		})
+
+		It("is satisfied by the creation observed before the request returned", func() {
+			exp.ExpectGeneratedCreations(owner, template, 1)
+			exp.CreationObserved(generated, owner)
+			Expect(satisfied(owner)).To(BeTrue())
+			exp.GeneratedCreationSucceeded(owner, generated)
+			Expect(satisfied(owner)).To(BeTrue())
+		})
+
+		It("ignores slaves of other owners and repeated observations", func() {
+			exp.ExpectGeneratedCreations(owner, template, 2)
+			exp.CreationObserved(generated, other)
+			Expect(satisfied(owner)).To(BeFalse())
+			exp.CreationObserved(generated, owner)
+			exp.CreationObserved(generated, owner)
+			Expect(satisfied(owner)).To(BeFalse())
+			exp.CreationObserved(resources.NewClusterKey("c", secrets, "default", "slave-abcde"), owner)
+			Expect(satisfied(owner)).To(BeTrue())
+		})
+
+		It("ignores slaves of other namespaces", func() {
+			exp.ExpectGeneratedCreations(owner, template, 1)
+			exp.CreationObserved(resources.NewClusterKey("c", secrets, "other", "slave-x7f2k"), owner)
+			Expect(satisfied(owner)).To(BeFalse())
+		})
+
+		It("ignores updates of existing siblings while a creation is pending", func() {
+			sibling := resources.NewClusterKey("c", secrets, "default", "slave-abc12")
+			exp.ExpectGeneratedCreations(owner, template, 1, sibling)
+			// update event of the existing sibling
+			exp.CreationObserved(sibling, owner)
+			Expect(satisfied(owner)).To(BeFalse())
+			exp.GeneratedCreationSucceeded(owner, generated)
+			Expect(satisfied(owner)).To(BeFalse())
+			exp.CreationObserved(generated, owner)
+			Expect(satisfied(owner)).To(BeTrue())
+		})
+
+		It("is lowered for failed requests", func() {
+			exp.ExpectGeneratedCreations(owner, template, 2)
+			exp.LowerGeneratedCreations(owner, template, 1)
+			Expect(satisfied(owner)).To(BeFalse())
+			exp.LowerGeneratedCreations(owner, template, 1)
+			Expect(satisfied(owner)).To(BeTrue())
+		})
+	})
+})
@@ -9,7 +9,6 @@ package resources
 import (
 	"context"
 	"math/rand"
-	"strings"
 	"sync"
 	"time"
 
@@ -129,18 +128,11 @@ func (f *genericInformerFactory) newInformer(lw listWatchFactory) (GenericInform
 	}
 	informer := cache.NewSharedIndexInformer(listWatch, lw.ExampleObject(), resyncPeriod(lw.Resync())(),
 		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
-	if err := informer.SetWatchErrorHandler(cache.WatchErrorHandler(panicWatchErrorHandler)); err != nil {
+	generic := &genericInformer{SharedIndexInformer: informer, resource: lw.Info()}
+	if err := informer.SetWatchErrorHandler(cache.WatchErrorHandler(generic.watchErrorHandler)); err != nil {
 		return nil, err
 	}
-	return &genericInformer{informer, lw.Info()}, nil
-}
-
-// panic if watched resource version has vanished (eg. CRD has been deleted or kube-apiserver has been updated)
-func panicWatchErrorHandler(r *cache.Reflector, err error) {
-	if strings.Contains(err.Error(), "failed to list") && strings.Contains(err.Error(), "the server could not find the requested resource") {
-		panic(err)
-	}
-	cache.DefaultWatchErrorHandler(context.Background(), r, err)
+	return generic, nil
 }
 
 // resyncPeriod returns a function which generates a duration each time it is
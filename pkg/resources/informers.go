@@ -30,6 +30,14 @@ type GenericInformer interface {
 type genericInformer struct {
 	cache.SharedIndexInformer
 	resource *Info
+
+	lock sync.Mutex
+	// watchErr is the last error reported by the reflector.
+	watchErr error
+	// timedOut is set if the cache could not be synced in time.
+	timedOut bool
+	// lost is set if the watched resource has vanished after the cache has been synced.
+	lost bool
 }
 
 func (f *genericInformer) Informer() cache.SharedIndexInformer {
@@ -61,6 +69,9 @@ type SharedInformerFactory interface {
 
 	Start(stopCh <-chan struct{})
 	WaitForCacheSync(stopCh <-chan struct{})
+
+	// InformerStatus returns the state of all informers.
+	InformerStatus() []InformerStatus
 }
 
 type GenericInformerFactory interface {
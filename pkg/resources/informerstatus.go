@@ -0,0 +1,89 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package resources
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// InformerStatus describes the state of an informer
+// of a shared informer factory.
+type InformerStatus struct {
+	GroupVersionKind schema.GroupVersionKind
+	// Namespace is the namespace watched by the informer, or empty for all namespaces.
+	Namespace string
+	Started   bool
+	Synced    bool
+	// TimedOut is set if the cache could not be synced within the
+	// cache sync timeout and is still not synced.
+	TimedOut bool
+	// Lost is set if the watched resource has vanished
+	// after the cache has been synced.
+	Lost bool
+	// Error is the last error of the list and watch requests
+	// of an informer, which is not synced or lost.
+	Error string
+}
+
+func (f *genericInformerFactory) informerStatus() []InformerStatus {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var result []InformerStatus
+	for gvk, informer := range f.informers {
+		started := f.startedInformers[gvk]
+		status := InformerStatus{
+			GroupVersionKind: gvk,
+			Namespace:        f.namespace,
+			Started:          started,
+			Synced:           started && informer.HasSynced(),
+		}
+		if generic, ok := informer.(*genericInformer); ok && started {
+			timedOut, lost, err := generic.syncState()
+			status.TimedOut = timedOut && !status.Synced
+			status.Lost = lost
+			if err != nil && (lost || !status.Synced) {
+				status.Error = err.Error()
+			}
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+func (f *sharedFilteredInformerFactory) informerStatus() []InformerStatus {
+	f.lock.Lock()
+	factories := []*genericInformerFactory{}
+	for _, i := range f.filters {
+		factories = append(factories, i)
+	}
+	f.lock.Unlock()
+
+	var result []InformerStatus
+	for _, i := range factories {
+		result = append(result, i.informerStatus()...)
+	}
+	return result
+}
+
+// InformerStatus returns the state of all informers
+// ordered by group version kind and namespace.
+func (f *sharedInformerFactory) InformerStatus() []InformerStatus {
+	var result []InformerStatus
+	for _, i := range []*sharedFilteredInformerFactory{f.structured, f.unstructured, f.minimalObject} {
+		result = append(result, i.informerStatus()...)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GroupVersionKind != result[j].GroupVersionKind {
+			return result[i].GroupVersionKind.String() < result[j].GroupVersionKind.String()
+		}
+		return result[i].Namespace < result[j].Namespace
+	})
+	return result
+}
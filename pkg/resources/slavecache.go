@@ -8,6 +8,7 @@ package resources
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gardener/controller-manager-library/pkg/logger"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,13 +26,36 @@ type entry struct {
 }
 
 type SlaveCache struct {
-	migration   ClusterIdMigration
-	gkMigration GroupKindMigration
-	cache       SubObjectCache
+	migration    ClusterIdMigration
+	gkMigration  GroupKindMigration
+	cache        SubObjectCache
+	expectations *Expectations
 }
 
 func NewSlaveCache(migration ClusterIdMigration, gkMigration GroupKindMigration) *SlaveCache {
-	return &SlaveCache{migration, gkMigration, *NewSubObjectCache(func(o Object) ClusterObjectKeySet { return o.GetOwners() })}
+	return &SlaveCache{migration: migration, gkMigration: gkMigration, cache: *NewSubObjectCache(func(o Object) ClusterObjectKeySet { return o.GetOwners() })}
+}
+
+// EnableExpectations enables the tracking of slave creations and deletions
+// done via the cache until they are observed by the slave reconciler.
+func (this *SlaveCache) EnableExpectations(timeout time.Duration) *SlaveCache {
+	if this.expectations == nil {
+		this.expectations = NewExpectations(timeout)
+	}
+	return this
+}
+
+// Expectations returns the expectation tracker of the cache,
+// or nil if expectations are not enabled.
+func (this *SlaveCache) Expectations() *Expectations {
+	return this.expectations
+}
+
+// ExpectationsSatisfied checks whether all slave creations and deletions
+// done for an owner are observed. If not, the remaining time until the
+// expectations expire is returned.
+func (this *SlaveCache) ExpectationsSatisfied(owner ClusterObjectKey) (bool, time.Duration) {
+	return this.expectations.Satisfied(owner)
 }
 
 func (this *SlaveCache) AddOwnerFilter(filters ...KeyFilter) *SlaveCache {
@@ -89,14 +113,17 @@ func (this *SlaveCache) GetOwnersFor(key ClusterObjectKey, kinds ...schema.Group
 }
 
 func (this *SlaveCache) DeleteSlave(key ClusterObjectKey) {
+	this.expectations.DeletionObserved(key)
 	this.cache.DeleteSubObject(key)
 }
 
 func (this *SlaveCache) DeleteOwner(key ClusterObjectKey) {
+	this.expectations.DeleteOwner(key)
 	this.cache.DeleteOwner(key)
 }
 
 func (this *SlaveCache) RenewSlaveObject(obj Object) bool {
+	this.expectations.CreationObserved(obj.ClusterKey(), obj.GetOwners().AsArray()...)
 	return this.cache.RenewSubObject(obj)
 }
 
@@ -131,7 +158,41 @@ func (this *SlaveCache) AddSlave(obj Object, slave Object) error {
 
 func (this *SlaveCache) CreateSlave(obj Object, slave Object) error {
 	slave.AddOwner(obj)
-	return this.cache.CreateSubObject(slave)
+	if this.expectations == nil {
+		return this.cache.CreateSubObject(slave)
+	}
+	if slave.GetName() != "" {
+		this.expectations.ExpectCreations(obj.ClusterKey(), slave.ClusterKey())
+		err := this.cache.CreateSubObject(slave)
+		if err != nil {
+			this.expectations.CancelExpectation(obj.ClusterKey(), slave.ClusterKey())
+		}
+		return err
+	}
+	template := slave.ClusterKey()
+	var known []ClusterObjectKey
+	for _, o := range this.cache.GetByOwnerKey(obj.ClusterKey()) {
+		known = append(known, o.ClusterKey())
+	}
+	this.expectations.ExpectGeneratedCreations(obj.ClusterKey(), template, 1, known...)
+	err := this.cache.CreateSubObject(slave)
+	if err != nil {
+		this.expectations.LowerGeneratedCreations(obj.ClusterKey(), template, 1)
+	} else {
+		this.expectations.GeneratedCreationSucceeded(obj.ClusterKey(), slave.ClusterKey())
+	}
+	return err
+}
+
+// DeleteSlaveObject deletes a slave of an owner. If expectations are
+// enabled, the deletion is expected until observed by the slave reconciler.
+func (this *SlaveCache) DeleteSlaveObject(obj Object, slave Object) error {
+	this.expectations.ExpectDeletions(obj.ClusterKey(), slave.ClusterKey())
+	err := slave.Delete()
+	if err != nil {
+		this.expectations.CancelExpectation(obj.ClusterKey(), slave.ClusterKey())
+	}
+	return err
 }
 
 func (this *SlaveCache) CreateOrModifySlave(obj Object, slave Object, mod Modifier) (bool, error) {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/gardener/controller-manager-library/pkg/config"
 	"github.com/gardener/controller-manager-library/pkg/configmain"
+	"github.com/gardener/controller-manager-library/pkg/logger"
 )
 
 const OPTION_SOURCE = "run"
@@ -25,6 +26,7 @@ type Config struct {
 }
 
 var _ config.OptionSource = (*Config)(nil)
+var _ config.Reconfigurable = (*Config)(nil)
 
 func init() {
 	configmain.RegisterExtension(func(cfg *configmain.Config) {
@@ -52,9 +54,22 @@ func (this *Config) AddOptionsToSet(set config.OptionSet) {
 	set.AddStringOption(&this.Namespace, "namespace", "", namespace, "namespace for lease")
 	set.AddStringOption(&this.PluginDir, "plugin-file", "", "", "directory containing go plugins")
 	set.AddStringOption(&this.LogLevel, "log-level", "D", "", "logrus log level")
+	config.MarkReloadable(set, "log-level")
 	set.AddStringOption(&this.CPUProfile, "cpuprofile", "", "", "set file for cpu profiling")
 }
 
+// Reconfigure changes the log level if it is changed in the config file.
+func (this *Config) Reconfigure(changes config.OptionChanges) error {
+	if c := changes["log-level"]; c != nil {
+		level := this.LogLevel
+		if level == "" {
+			level = "info"
+		}
+		return logger.SetLevel(level)
+	}
+	return nil
+}
+
 func GetConfig(cfg *configmain.Config) *Config {
 	return cfg.GetSource(OPTION_SOURCE).(*Config)
 }
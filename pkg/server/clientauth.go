@@ -0,0 +1,268 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/client-go/util/cert"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+)
+
+// ClientAuthConfig describes the verification of client certificates
+// for an HTTPS server.
+type ClientAuthConfig struct {
+	// CABundle are the PEM encoded CA certificates used to verify client certificates.
+	CABundle []byte
+	// CAFile is the file the CA bundle has been read from. If set, the file
+	// is watched and the CA bundle is reloaded on changes.
+	CAFile string
+	// Required rejects connections without a valid client certificate.
+	// Otherwise, client certificates are only verified if given.
+	Required bool
+	// Names restricts the accepted clients to identities matching
+	// one of the names or groups (see ClientIdentity.Matches).
+	Names []string
+}
+
+// ClientIdentity is the identity of a client
+// authenticated by a verified client certificate.
+type ClientIdentity struct {
+	// Name is the common name of the certificate subject.
+	Name           string
+	Organizations  []string
+	DNSNames       []string
+	EmailAddresses []string
+	URIs           []string
+	Certificate    *x509.Certificate
+}
+
+// NewClientIdentity maps a verified client certificate to a client identity.
+func NewClientIdentity(cert *x509.Certificate) *ClientIdentity {
+	id := &ClientIdentity{
+		Name:           cert.Subject.CommonName,
+		Organizations:  cert.Subject.Organization,
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+		Certificate:    cert,
+	}
+	for _, u := range cert.URIs {
+		id.URIs = append(id.URIs, u.String())
+	}
+	return id
+}
+
+func (this *ClientIdentity) String() string {
+	if len(this.Organizations) > 0 {
+		return fmt.Sprintf("%s (%s)", this.Name, strings.Join(this.Organizations, ","))
+	}
+	return this.Name
+}
+
+// GroupPrefix is the prefix of names matching an organization
+// of the certificate subject instead of a name.
+const GroupPrefix = "group:"
+
+// Matches checks whether the common name or one of the subject
+// alternative names match the given name. Names with the prefix
+// GroupPrefix match an organization of the subject.
+func (this *ClientIdentity) Matches(name string) bool {
+	if group, ok := strings.CutPrefix(name, GroupPrefix); ok {
+		for _, o := range this.Organizations {
+			if o == group {
+				return true
+			}
+		}
+		return false
+	}
+	if this.Name == name {
+		return true
+	}
+	for _, list := range [][]string{this.DNSNames, this.EmailAddresses, this.URIs} {
+		for _, n := range list {
+			if n == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type clientIdentityKey struct{}
+
+// GetClientIdentity returns the identity of the client of a request
+// authenticated by a verified client certificate, or nil.
+func GetClientIdentity(r *http.Request) *ClientIdentity {
+	if id, ok := r.Context().Value(clientIdentityKey{}).(*ClientIdentity); ok {
+		return id
+	}
+	return nil
+}
+
+// tweak configures the client certificate verification. A CA file
+// is watched until the context is done.
+func (this *ClientAuthConfig) tweak(ctx context.Context, log logger.LogContext, tlscfg *tls.Config) error {
+	pool, err := newClientCAPool(this.CABundle)
+	if err != nil {
+		return err
+	}
+	tlscfg.ClientCAs = pool
+	if this.Required {
+		tlscfg.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		tlscfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	if this.CAFile != "" {
+		cas := &clientCAs{logger: log, path: this.CAFile, pool: pool}
+		if err := cas.watch(ctx); err != nil {
+			log.Warnf("cannot watch client CA file %q: %s (no reload)", this.CAFile, err)
+			return nil
+		}
+		tlscfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := tlscfg.Clone()
+			cfg.GetConfigForClient = nil
+			cfg.ClientCAs = cas.get()
+			return cfg, nil
+		}
+	}
+	return nil
+}
+
+func newClientCAPool(data []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if err := certmgmt.AppendCertsFromPEM(pool, data); err != nil {
+		return nil, fmt.Errorf("invalid client CA bundle: %s", err)
+	}
+	return pool, nil
+}
+
+// clientCAs keeps the CA pool read from a client CA file. The file is
+// watched by its directory, because secret volumes replace the files
+// by switching a symbolic link.
+type clientCAs struct {
+	logger logger.LogContext
+	path   string
+
+	lock sync.RWMutex
+	pool *x509.CertPool
+}
+
+func (this *clientCAs) get() *x509.CertPool {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	return this.pool
+}
+
+// reload reads the CA file. An invalid file keeps the actual pool.
+func (this *clientCAs) reload() error {
+	data, err := os.ReadFile(this.path)
+	if err != nil {
+		return err
+	}
+	if _, err := cert.ParseCertsPEM(data); err != nil {
+		return err
+	}
+	pool, err := newClientCAPool(data)
+	if err != nil {
+		return err
+	}
+	this.lock.Lock()
+	this.pool = pool
+	this.lock.Unlock()
+	return nil
+}
+
+func (this *clientCAs) relevant(name string) bool {
+	return filepath.Clean(name) == filepath.Clean(this.path) ||
+		filepath.Base(name) == "..data" && filepath.Dir(name) == filepath.Dir(this.path)
+}
+
+func (this *clientCAs) watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(this.path)); err != nil {
+		_ = watcher.Close()
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		_ = watcher.Close()
+	}()
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 || !this.relevant(event.Name) {
+					continue
+				}
+				if err := this.reload(); err != nil {
+					this.logger.Warnf("cannot reload client CA file %q: %s", this.path, err)
+				} else {
+					this.logger.Infof("client CA file %q reloaded", this.path)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				this.logger.Errorf("client CA file watch error: %s", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// handler passes the client identity to the handlers and
+// rejects clients not matching the configured names.
+func (this *ClientAuthConfig) handler(log logger.LogContext, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id *ClientIdentity
+		if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+			id = NewClientIdentity(r.TLS.VerifiedChains[0][0])
+		}
+		if len(this.Names) > 0 && !this.accepted(id) {
+			if id == nil {
+				log.Warnf("client without certificate rejected for %s", r.URL.Path)
+			} else {
+				log.Warnf("client %s rejected for %s", id, r.URL.Path)
+			}
+			http.Error(w, "client not accepted", http.StatusForbidden)
+			return
+		}
+		if id != nil {
+			r = r.WithContext(context.WithValue(r.Context(), clientIdentityKey{}, id))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (this *ClientAuthConfig) accepted(id *ClientIdentity) bool {
+	if id == nil {
+		return false
+	}
+	for _, n := range this.Names {
+		if id.Matches(n) {
+			return true
+		}
+	}
+	return false
+}
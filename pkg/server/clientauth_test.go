@@ -0,0 +1,260 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/cert"
+
+	"github.com/gardener/controller-manager-library/pkg/certmgmt"
+	"github.com/gardener/controller-manager-library/pkg/logger"
+	"github.com/gardener/controller-manager-library/pkg/utils/pkiutil"
+)
+
+type testCA struct {
+	key  crypto.Signer
+	cert *x509.Certificate
+}
+
+func newTestCA(name string) *testCA {
+	key, err := certmgmt.NewPrivateKey(certmgmt.KeyAlgorithmECDSA, 0)
+	Expect(err).To(Succeed())
+	c, err := cert.NewSelfSignedCACert(cert.Config{CommonName: name}, key)
+	Expect(err).To(Succeed())
+	return &testCA{key: key, cert: c}
+}
+
+func (this *testCA) PEM() []byte {
+	return pkiutil.EncodeCertPEM(this.cert)
+}
+
+func (this *testCA) issue(cfg cert.Config) tls.Certificate {
+	key, err := certmgmt.NewPrivateKey(certmgmt.KeyAlgorithmECDSA, 0)
+	Expect(err).To(Succeed())
+	c, err := certmgmt.NewSignedCert(&cfg, key, this.cert, this.key, time.Hour)
+	Expect(err).To(Succeed())
+	return tls.Certificate{Certificate: [][]byte{c.Raw}, PrivateKey: key, Leaf: c}
+}
+
+func (this *testCA) client(name string, orgs []string, usages ...x509.ExtKeyUsage) *tls.Certificate {
+	if len(usages) == 0 {
+		usages = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	c := this.issue(cert.Config{CommonName: name, Organization: orgs, Usages: usages})
+	return &c
+}
+
+var _ = Describe("client authentication", func() {
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		serverCA  *testCA
+		clientCA  *testCA
+		otherCA   *testCA
+		cfg       *ClientAuthConfig
+		server    *httptest.Server
+		serverTLS *tls.Config
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		serverCA = newTestCA("server-ca")
+		clientCA = newTestCA("client-ca")
+		otherCA = newTestCA("other-ca")
+		cfg = &ClientAuthConfig{CABundle: clientCA.PEM()}
+		serverTLS = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			Certificates: []tls.Certificate{serverCA.issue(cert.Config{
+				CommonName: "server",
+				AltNames:   cert.AltNames{IPs: []net.IP{net.ParseIP("127.0.0.1")}},
+				Usages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			})},
+		}
+	})
+
+	AfterEach(func() {
+		if server != nil {
+			server.Close()
+			server = nil
+		}
+		cancel()
+	})
+
+	start := func() {
+		Expect(cfg.tweak(ctx, logger.New(), serverTLS)).To(Succeed())
+		server = httptest.NewUnstartedServer(cfg.handler(logger.New(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if id := GetClientIdentity(r); id != nil {
+				fmt.Fprint(w, id.String())
+			} else {
+				fmt.Fprint(w, "anonymous")
+			}
+		})))
+		server.TLS = serverTLS
+		server.StartTLS()
+	}
+
+	get := func(client *tls.Certificate) (int, string, error) {
+		roots := x509.NewCertPool()
+		roots.AddCert(serverCA.cert)
+		tlscfg := &tls.Config{MinVersion: tls.VersionTLS12, RootCAs: roots}
+		if client != nil {
+			// present the certificate even if not issued by a CA accepted by the server
+			tlscfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				return client, nil
+			}
+		}
+		c := &http.Client{Transport: &http.Transport{TLSClientConfig: tlscfg}}
+		defer c.CloseIdleConnections()
+		resp, err := c.Get(server.URL + "/test")
+		if err != nil {
+			return 0, "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body), err
+	}
+
+	type request struct {
+		names    []string
+		required bool
+		client   func() *tls.Certificate
+		code     int
+		body     string
+		fails    bool
+	}
+
+	DescribeTable("verifies clients",
+		func(r request) {
+			cfg.Names = r.names
+			cfg.Required = r.required
+			start()
+			var client *tls.Certificate
+			if r.client != nil {
+				client = r.client()
+			}
+			code, body, err := get(client)
+			if r.fails {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).To(Succeed())
+			Expect(code).To(Equal(r.code))
+			if r.body != "" {
+				Expect(body).To(Equal(r.body))
+			}
+		},
+		Entry("passes the identity of a client", request{
+			client: func() *tls.Certificate { return clientCA.client("client", []string{"team"}) },
+			code:   http.StatusOK, body: "client (team)",
+		}),
+		Entry("accepts a client with a matching name", request{
+			names:  []string{"other", "client"},
+			client: func() *tls.Certificate { return clientCA.client("client", nil) },
+			code:   http.StatusOK, body: "client",
+		}),
+		Entry("accepts a client with a matching group", request{
+			names:  []string{"group:admins"},
+			client: func() *tls.Certificate { return clientCA.client("client", []string{"users", "admins"}) },
+			code:   http.StatusOK, body: "client (users,admins)",
+		}),
+		Entry("rejects a client with the wrong name", request{
+			names:  []string{"admin", "group:admins"},
+			client: func() *tls.Certificate { return clientCA.client("client", []string{"users"}) },
+			code:   http.StatusForbidden,
+		}),
+		Entry("accepts anonymous clients without names", request{
+			code: http.StatusOK, body: "anonymous",
+		}),
+		Entry("rejects anonymous clients with names", request{
+			names: []string{"client"},
+			code:  http.StatusForbidden,
+		}),
+		Entry("rejects anonymous clients if required", request{
+			required: true,
+			fails:    true,
+		}),
+		Entry("rejects certificates without client usage", request{
+			client: func() *tls.Certificate {
+				return clientCA.client("client", nil, x509.ExtKeyUsageServerAuth)
+			},
+			fails: true,
+		}),
+		Entry("rejects certificates of another CA", request{
+			client: func() *tls.Certificate { return otherCA.client("client", nil) },
+			fails:  true,
+		}),
+	)
+
+	It("reloads the client CA file", func() {
+		dir, err := os.MkdirTemp("", "clientca")
+		Expect(err).To(Succeed())
+		defer os.RemoveAll(dir)
+		file := filepath.Join(dir, "ca.crt")
+		Expect(os.WriteFile(file, clientCA.PEM(), 0600)).To(Succeed())
+		cfg.CAFile = file
+		start()
+
+		other := otherCA.client("client", nil)
+		_, _, err = get(other)
+		Expect(err).To(HaveOccurred())
+
+		Expect(os.WriteFile(file, otherCA.PEM(), 0600)).To(Succeed())
+		Eventually(func() error {
+			_, _, err := get(other)
+			return err
+		}, 5*time.Second, 50*time.Millisecond).Should(Succeed())
+		_, _, err = get(clientCA.client("client", nil))
+		Expect(err).To(HaveOccurred())
+
+		// invalid files keep the actual bundle
+		Expect(os.WriteFile(file, []byte("invalid"), 0600)).To(Succeed())
+		Consistently(func() error {
+			_, _, err := get(other)
+			return err
+		}, 500*time.Millisecond, 100*time.Millisecond).Should(Succeed())
+	})
+
+	DescribeTable("matches names and groups",
+		func(name string, expected bool) {
+			u, err := url.Parse("spiffe://example.com/client")
+			Expect(err).To(Succeed())
+			id := NewClientIdentity(&x509.Certificate{
+				Subject:        pkix.Name{CommonName: "client", Organization: []string{"users", "admins"}},
+				DNSNames:       []string{"client.example.com"},
+				EmailAddresses: []string{"client@example.com"},
+				URIs:           []*url.URL{u},
+			})
+			Expect(id.Matches(name)).To(Equal(expected))
+		},
+		Entry("common name", "client", true),
+		Entry("DNS name", "client.example.com", true),
+		Entry("email address", "client@example.com", true),
+		Entry("URI", "spiffe://example.com/client", true),
+		Entry("group", "group:admins", true),
+		Entry("other name", "admin", false),
+		Entry("other group", "group:operators", false),
+		Entry("organization as name", "admins", false),
+		Entry("common name as group", "group:client", false),
+	)
+})
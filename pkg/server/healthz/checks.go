@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package healthz
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Status is the state of a component reported by a check.
+type Status struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason,omitempty"`
+	// Components are the states of sub components,
+	// for example the informers of a cluster.
+	Components []Status `json:"components,omitempty"`
+}
+
+// NewStatus creates a status for a healthy component if no reason is given.
+func NewStatus(name string, reason string) Status {
+	return Status{Name: name, Healthy: reason == "", Reason: reason}
+}
+
+// Checker determines the actual status of a component.
+type Checker interface {
+	Check() Status
+}
+
+// CheckerFunc implements the Checker interface by a function.
+type CheckerFunc func() Status
+
+func (this CheckerFunc) Check() Status {
+	return this()
+}
+
+// Report is the result of the execution of a set of checks.
+type Report struct {
+	Healthy bool     `json:"healthy"`
+	Checks  []Status `json:"checks"`
+}
+
+// Checks is a set of named checks served by an HTTP endpoint.
+// Like the kube-apiserver endpoints, the query parameter
+// verbose reports the status of all checks (as JSON), the
+// query parameter exclude omits checks and the sub path
+// <endpoint>/<check> executes a dedicated check, only.
+type Checks struct {
+	lock   sync.RWMutex
+	name   string
+	empty  string
+	checks map[string]Checker
+}
+
+// NewChecks creates a new set of checks. If empty is set, the
+// endpoint reports a failure with this reason if no check is registered.
+func NewChecks(name string, empty string) *Checks {
+	return &Checks{name: name, empty: empty, checks: map[string]Checker{}}
+}
+
+// Register adds or replaces a named check.
+func (this *Checks) Register(name string, checker Checker) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.checks[name] = checker
+}
+
+// Unregister removes a named check.
+func (this *Checks) Unregister(name string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	delete(this.checks, name)
+}
+
+// Names returns the sorted names of the registered checks.
+func (this *Checks) Names() []string {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	names := make([]string, 0, len(this.checks))
+	for n := range this.checks {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns a named check.
+func (this *Checks) Get(name string) Checker {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	return this.checks[name]
+}
+
+// Execute executes the checks, which are not excluded. If no names
+// are given, all checks are executed.
+func (this *Checks) Execute(names []string, exclude ...string) *Report {
+	if len(names) == 0 {
+		names = this.Names()
+	}
+	excluded := map[string]bool{}
+	for _, e := range exclude {
+		excluded[e] = true
+	}
+	report := &Report{Healthy: true}
+	for _, n := range names {
+		if excluded[n] {
+			continue
+		}
+		c := this.Get(n)
+		if c == nil {
+			continue
+		}
+		status := c.Check()
+		status.Name = n
+		if !status.Healthy {
+			report.Healthy = false
+		}
+		report.Checks = append(report.Checks, status)
+	}
+	if len(report.Checks) == 0 && this.empty != "" {
+		report.Healthy = false
+		report.Checks = append(report.Checks, NewStatus(this.name, this.empty))
+	}
+	return report
+}
+
+// Text formats a report like the kube-apiserver health endpoints.
+func (this *Report) Text(name string) string {
+	s := ""
+	for _, c := range this.Checks {
+		if c.Healthy {
+			s += fmt.Sprintf("[+]%s ok\n", c.Name)
+		} else {
+			s += fmt.Sprintf("[-]%s failed: %s\n", c.Name, c.Reason)
+		}
+	}
+	if this.Healthy {
+		return s + name + " check passed\n"
+	}
+	return s + name + " check failed\n"
+}
+
+// Handler returns an HTTP handler serving the checks at the given path.
+func (this *Checks) Handler(path string) http.Handler {
+	path = strings.TrimSuffix(path, "/")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var names []string
+		if sub := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, path), "/"); sub != "" {
+			if this.Get(sub) == nil {
+				http.Error(w, fmt.Sprintf("no %s check %q", this.name, sub), http.StatusNotFound)
+				return
+			}
+			names = []string{sub}
+		}
+		query := r.URL.Query()
+		report := this.Execute(names, query["exclude"]...)
+
+		code := http.StatusOK
+		if !report.Healthy {
+			code = http.StatusInternalServerError
+		}
+		if _, verbose := query["verbose"]; verbose {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(code)
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			_ = enc.Encode(report)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(code)
+		_, _ = w.Write([]byte(report.Text(this.name)))
+	})
+}
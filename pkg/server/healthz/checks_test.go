@@ -0,0 +1,138 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ */
+
+package healthz
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func serve(handler http.Handler, target string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+var _ = ginkgo.Describe("checks", func() {
+	var checks *Checks
+	var handler http.Handler
+
+	ginkgo.BeforeEach(func() {
+		checks = NewChecks("readyz", "")
+		checks.Register("cluster/default", CheckerFunc(func() Status {
+			return Status{Healthy: true, Reason: "API server version v1.34.1"}
+		}))
+		checks.Register("informers/default", CheckerFunc(func() Status {
+			status := NewStatus("", "1 of 2 informers not synced")
+			status.Components = []Status{
+				NewStatus("/v1, Kind=Secret", ""),
+				NewStatus("apps/v1, Kind=Deployment", "not synced"),
+			}
+			return status
+		}))
+		handler = checks.Handler("/readyz")
+	})
+
+	ginkgo.It("reports all checks as text", func() {
+		w := serve(handler, "/readyz")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(w.Header().Get("Content-Type")).To(Equal("text/plain; charset=utf-8"))
+		Expect(w.Body.String()).To(Equal("[+]cluster/default ok\n" +
+			"[-]informers/default failed: 1 of 2 informers not synced\n" +
+			"readyz check failed\n"))
+	})
+
+	ginkgo.It("omits excluded checks", func() {
+		w := serve(handler, "/readyz?exclude=informers/default")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("[+]cluster/default ok\nreadyz check passed\n"))
+	})
+
+	ginkgo.It("omits several excluded checks and ignores unknown ones", func() {
+		w := serve(handler, "/readyz?exclude=informers/default&exclude=cluster/default&exclude=unknown")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("readyz check passed\n"))
+	})
+
+	ginkgo.It("reports the status of all checks as JSON", func() {
+		w := serve(handler, "/readyz?verbose")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		report := &Report{}
+		Expect(json.Unmarshal(w.Body.Bytes(), report)).To(Succeed())
+		Expect(report).To(Equal(&Report{
+			Healthy: false,
+			Checks: []Status{
+				{Name: "cluster/default", Healthy: true, Reason: "API server version v1.34.1"},
+				{Name: "informers/default", Healthy: false, Reason: "1 of 2 informers not synced", Components: []Status{
+					{Name: "/v1, Kind=Secret", Healthy: true},
+					{Name: "apps/v1, Kind=Deployment", Healthy: false, Reason: "not synced"},
+				}},
+			},
+		}))
+	})
+
+	ginkgo.It("combines verbose and exclude", func() {
+		w := serve(handler, "/readyz?verbose&exclude=informers/default")
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		report := &Report{}
+		Expect(json.Unmarshal(w.Body.Bytes(), report)).To(Succeed())
+		Expect(report.Healthy).To(BeTrue())
+		Expect(report.Checks).To(HaveLen(1))
+		Expect(report.Checks[0].Name).To(Equal("cluster/default"))
+	})
+
+	ginkgo.It("executes a dedicated check by its sub path", func() {
+		w := serve(handler, "/readyz/cluster/default")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("[+]cluster/default ok\nreadyz check passed\n"))
+
+		w = serve(handler, "/readyz/informers/default?verbose")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		report := &Report{}
+		Expect(json.Unmarshal(w.Body.Bytes(), report)).To(Succeed())
+		Expect(report.Checks).To(HaveLen(1))
+		Expect(report.Checks[0].Components).To(HaveLen(2))
+	})
+
+	ginkgo.It("rejects an unknown sub path", func() {
+		w := serve(handler, "/readyz/unknown")
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+		Expect(w.Body.String()).To(ContainSubstring(`no readyz check "unknown"`))
+	})
+
+	ginkgo.It("serves a path with a trailing slash", func() {
+		w := serve(checks.Handler("/readyz/"), "/readyz/cluster/default")
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	ginkgo.It("reports unregistered checks no longer", func() {
+		checks.Unregister("informers/default")
+		Expect(checks.Names()).To(Equal([]string{"cluster/default"}))
+		w := serve(handler, "/readyz")
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	ginkgo.It("fails without checks if an empty reason is given", func() {
+		w := serve(NewChecks("readyz", "no ready reporter configured").Handler("/readyz"), "/readyz")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(w.Body.String()).To(Equal("[-]readyz failed: no ready reporter configured\nreadyz check failed\n"))
+	})
+
+	ginkgo.It("succeeds without checks if no empty reason is given", func() {
+		w := serve(NewChecks("healthz", "").Handler("/healthz"), "/healthz")
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("healthz check passed\n"))
+	})
+})
@@ -7,24 +7,34 @@
 package healthz
 
 import (
-	"io"
 	"net/http"
 
 	"github.com/gardener/controller-manager-library/pkg/server"
 )
 
+// HealthChecks are the checks served by the /healthz endpoint.
+var HealthChecks = NewChecks("healthz", "")
+
 func init() {
 	server.Register("/healthz", Healthz)
+	server.Register("/healthz/", Healthz)
 }
 
 // Healthz is a HTTP handler for the /healthz endpoint which responses with 200 OK status code
 // if the Gardener controller manager is healthy; and with 500 Internal Server error status code otherwise.
-func Healthz(w http.ResponseWriter, _ *http.Request) {
-	ok, info := HealthInfo()
-	if ok {
-		w.WriteHeader(http.StatusOK)
-	} else {
-		w.WriteHeader(http.StatusInternalServerError)
-	}
-	_, _ = io.WriteString(w, info)
+// With the query parameter verbose the status of all checks is reported as JSON,
+// dedicated checks can be executed with /healthz/<check> and excluded with
+// the query parameter exclude.
+func Healthz(w http.ResponseWriter, r *http.Request) {
+	HealthChecks.Handler("/healthz").ServeHTTP(w, r)
+}
+
+// Register registers a named health check.
+func Register(name string, checker Checker) {
+	HealthChecks.Register(name, checker)
+}
+
+// Unregister removes a named health check.
+func Unregister(name string) {
+	HealthChecks.Unregister(name)
 }
@@ -25,11 +25,15 @@ func Tick(key string) {
 	}
 }
 
+// Start starts a tick based health check. The check
+// fails if there is no tick for three periods.
 func Start(key string, period time.Duration) {
 	lock.Lock()
 	defer lock.Unlock()
 
-	checks[key] = &check{time.Now(), 3 * period}
+	c := &check{key: key, last: time.Now(), timeout: 3 * period}
+	checks[key] = c
+	Register(key, c)
 }
 
 func End(key string) {
@@ -40,6 +44,7 @@ func End(key string) {
 }
 
 type check struct {
+	key     string
 	last    time.Time
 	timeout time.Duration
 }
@@ -51,39 +56,30 @@ var (
 
 func removeCheck(key string) {
 	delete(checks, key)
+	Unregister(key)
 }
 
-func IsHealthy() bool {
+func (this *check) Check() Status {
 	lock.Lock()
 	defer lock.Unlock()
 
 	now := time.Now()
-
-	for key, c := range checks {
-		limit := now.Add(-c.timeout)
-		if c.last.Before(limit) {
-			logger.Warnf("outdated health check '%s': %s", key, limit.Sub(c.last))
-			return false
-		}
-		logger.Debugf("%s: %s", key, c.last)
+	limit := now.Add(-this.timeout)
+	if this.last.Before(limit) {
+		logger.Warnf("outdated health check '%s': %s", this.key, limit.Sub(this.last))
+		return NewStatus(this.key, fmt.Sprintf("no tick since %s (timeout %s)", now.Sub(this.last).Round(time.Second), this.timeout))
 	}
-	return true
+	logger.Debugf("%s: %s", this.key, this.last)
+	return NewStatus(this.key, "")
 }
 
-func HealthInfo() (bool, string) {
-	lock.Lock()
-	defer lock.Unlock()
+func IsHealthy() bool {
+	return HealthChecks.Execute(nil).Healthy
+}
 
-	info := ""
-	now := time.Now()
-	for key, c := range checks {
-		limit := now.Add(-c.timeout)
-		info = fmt.Sprintf("%s%s: %s\n", info, key, c.last)
-		if c.last.Before(limit) {
-			logger.Warnf("outdated health check '%s': %s", key, limit.Sub(c.last))
-			return false, info
-		}
-		logger.Debugf("%s: %s", key, c.last)
-	}
-	return true, info
+// HealthInfo reports the health state and a plain text
+// description of all health checks.
+func HealthInfo() (bool, string) {
+	report := HealthChecks.Execute(nil)
+	return report.Healthy, report.Text("healthz")
 }
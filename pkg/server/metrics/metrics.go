@@ -0,0 +1,22 @@
+/*
+ * SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// Package metrics serves the metrics of the default prometheus registry on
+// /metrics of the default HTTP server. The endpoint is only provided, if
+// this package is imported by the controller manager:
+//
+//	import _ "github.com/gardener/controller-manager-library/pkg/server/metrics"
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/gardener/controller-manager-library/pkg/server"
+)
+
+func init() {
+	server.RegisterHandler("/metrics", promhttp.Handler())
+}
@@ -26,7 +26,8 @@ type HTTPServer struct {
 	servMux *http.ServeMux
 	ctx     context.Context
 
-	server *http.Server
+	server     *http.Server
+	clientAuth *ClientAuthConfig
 	logger.LogContext
 }
 
@@ -52,9 +53,16 @@ func (this *HTTPServer) RegisterHandler(pattern string, handler http.Handler) {
 	this.servMux.Handle(pattern, handler)
 }
 
+// SetClientAuth enables the verification of client certificates for
+// an HTTPS server. It must be called before the server is started.
+func (this *HTTPServer) SetClientAuth(cfg *ClientAuthConfig) {
+	this.clientAuth = cfg
+}
+
 // Start starts an HTTP/S server.
 func (this *HTTPServer) Start(source certs.CertificateSource, bindAddress string, port int, tweak ...TLSTweakFunction) {
 	var tlscfg *tls.Config
+	var handler http.Handler = this.servMux
 
 	listenAddress := fmt.Sprintf("%s:%d", bindAddress, port)
 	if source != nil {
@@ -64,6 +72,15 @@ func (this *HTTPServer) Start(source certs.CertificateSource, bindAddress string
 			MinVersion:     tls.VersionTLS12,
 			GetCertificate: source.GetCertificate,
 		}
+		if this.clientAuth != nil {
+			if err := this.clientAuth.tweak(this.ctx, this, tlscfg); err != nil {
+				logger.Errorf("cannot start server %q: %s", this.name, err)
+				ctxutil.Cancel(this.ctx)
+				return
+			}
+			this.Infof("verifying client certificates (required: %t)", this.clientAuth.Required)
+			handler = this.clientAuth.handler(this, handler)
+		}
 		for _, f := range tweak {
 			f(tlscfg)
 		}
@@ -72,7 +89,7 @@ func (this *HTTPServer) Start(source certs.CertificateSource, bindAddress string
 	}
 	this.server = &http.Server{
 		Addr:              listenAddress,
-		Handler:           this.servMux,
+		Handler:           handler,
 		TLSConfig:         tlscfg,
 		ReadHeaderTimeout: 3 * time.Second,
 	}